@@ -0,0 +1,92 @@
+// Package arrow exports HGNC records to Apache Arrow and Parquet, for loading into
+// analytics tools such as Spark or DuckDB. It is a separate module, so the core
+// package doesn't depend on arrow-go.
+package arrow
+
+import (
+	"bytes"
+	"io"
+
+	goarrow "github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+
+	hgnc "github.com/viktorxia/hgnc-go"
+)
+
+// ExportToArrow converts records to an Arrow IPC stream with one column per requested field,
+// named as in the HGNC file. Multi-value fields (split on "|") become List<Utf8> columns,
+// other fields Utf8 columns.
+func ExportToArrow(records []*hgnc.Record, fields []hgnc.Field) ([]byte, error) {
+
+	mem := memory.NewGoAllocator()
+	rec := newRecord(mem, records, fields)
+	defer rec.Release()
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(rec.Schema()), ipc.WithAllocator(mem))
+	if err := w.Write(rec); err != nil {
+		w.Close()
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ExportToParquet writes records to w in Parquet format, with the columns of ExportToArrow.
+func ExportToParquet(records []*hgnc.Record, fields []hgnc.Field, w io.Writer) error {
+
+	mem := memory.NewGoAllocator()
+	rec := newRecord(mem, records, fields)
+	defer rec.Release()
+
+	props := parquet.NewWriterProperties(parquet.WithAllocator(mem))
+	fw, err := pqarrow.NewFileWriter(rec.Schema(), w, props, pqarrow.DefaultWriterProps())
+	if err != nil {
+		return err
+	}
+	if err := fw.Write(rec); err != nil {
+		fw.Close()
+		return err
+	}
+	return fw.Close()
+}
+
+// schema returns the Arrow schema of fields.
+func schema(fields []hgnc.Field) *goarrow.Schema {
+
+	arrowFields := make([]goarrow.Field, len(fields))
+	for i, field := range fields {
+		arrowFields[i] = goarrow.Field{Name: string(field), Type: goarrow.BinaryTypes.String}
+		if field.IsMultiValue() {
+			arrowFields[i].Type = goarrow.ListOf(goarrow.BinaryTypes.String)
+		}
+	}
+	return goarrow.NewSchema(arrowFields, nil)
+}
+
+// newRecord builds a single Arrow record batch of records, the caller must release it.
+func newRecord(mem memory.Allocator, records []*hgnc.Record, fields []hgnc.Field) goarrow.Record {
+
+	b := array.NewRecordBuilder(mem, schema(fields))
+	defer b.Release()
+
+	for i, column := range hgnc.ExportColumns(records, fields) {
+		if column.Lists == nil {
+			b.Field(i).(*array.StringBuilder).AppendValues(column.Values, nil)
+			continue
+		}
+		lb := b.Field(i).(*array.ListBuilder)
+		vb := lb.ValueBuilder().(*array.StringBuilder)
+		for _, values := range column.Lists {
+			lb.Append(true)
+			vb.AppendValues(values, nil)
+		}
+	}
+	return b.NewRecord()
+}
@@ -0,0 +1,79 @@
+package arrow
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	goarrow "github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+
+	hgnc "github.com/viktorxia/hgnc-go"
+)
+
+var (
+	fixtureRecords = []*hgnc.Record{
+		hgnc.NewRecordBuilder().WithHgncID("HGNC:11998").WithSymbol("TP53").WithAliasSymbols("p53", "LFS1").Build(),
+		hgnc.NewRecordBuilder().WithHgncID("HGNC:1100").WithSymbol("BRCA1").WithAliasSymbols("RNF53", "BRCC1").Build(),
+		hgnc.NewRecordBuilder().WithHgncID("HGNC:31586").WithSymbol("MIR21").Build(),
+	}
+	fixtureFields = []hgnc.Field{hgnc.FIELD_HGNC_ID, hgnc.FIELD_SYMBOL, hgnc.FIELD_ALIAS_SYMBOL}
+)
+
+func TestExportToArrow(t *testing.T) {
+
+	data, err := ExportToArrow(fixtureRecords, fixtureFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := ipc.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Release()
+
+	if got := r.Schema().NumFields(); got != len(fixtureFields) {
+		t.Errorf("got %d columns, want %d", got, len(fixtureFields))
+	}
+	if got := r.Schema().Field(2).Type; !goarrow.TypeEqual(got, goarrow.ListOf(goarrow.BinaryTypes.String)) {
+		t.Errorf("alias_symbol column has type %s, want list<utf8>", got)
+	}
+
+	rows := 0
+	for r.Next() {
+		rows += int(r.Record().NumRows())
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if rows != len(fixtureRecords) {
+		t.Errorf("got %d rows, want %d", rows, len(fixtureRecords))
+	}
+}
+
+func TestExportToParquet(t *testing.T) {
+
+	var buf bytes.Buffer
+	if err := ExportToParquet(fixtureRecords, fixtureFields, &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	mem := memory.NewGoAllocator()
+	table, err := pqarrow.ReadTable(context.Background(), bytes.NewReader(buf.Bytes()),
+		parquet.NewReaderProperties(mem), pqarrow.ArrowReadProperties{}, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer table.Release()
+
+	if got := int(table.NumCols()); got != len(fixtureFields) {
+		t.Errorf("got %d columns, want %d", got, len(fixtureFields))
+	}
+	if got := int(table.NumRows()); got != len(fixtureRecords) {
+		t.Errorf("got %d rows, want %d", got, len(fixtureRecords))
+	}
+}
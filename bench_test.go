@@ -0,0 +1,158 @@
+package hgnc_go_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+// databaseSize is about the number of records in the HGNC complete set.
+const databaseSize = 45000
+
+var (
+	benchOnce sync.Once
+	benchDB   *h.HGNC
+)
+
+// benchLogger keeps loads quiet in benchmark output.
+var benchLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// benchHGNC returns the synthetic database shared by benchmarks, built on first use.
+func benchHGNC() *h.HGNC {
+	benchOnce.Do(func() {
+		// testutil loads with the default logger, which would interleave with benchmark output
+		defer slog.SetDefault(slog.Default())
+		slog.SetDefault(benchLogger)
+		benchDB = testutil.NewSyntheticHGNC(databaseSize)
+	})
+	return benchDB
+}
+
+// benchmarkFetch fetches values made from format and the record number.
+func benchmarkFetch(b *testing.B, hgnc *h.HGNC, field h.Field, format string) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hgnc.Fetch(fmt.Sprintf(format, i%databaseSize), field)
+	}
+}
+
+func BenchmarkFetchSymbol(b *testing.B) {
+	benchmarkFetch(b, benchHGNC(), h.FIELD_SYMBOL, "GENE%d")
+}
+
+func BenchmarkFetchVegaID(b *testing.B) {
+	// lazily indexed fields are built on first use, drop the index to measure the scan
+	hgnc := benchHGNC()
+	hgnc.RemoveIndex(h.FIELD_VEGA_ID)
+	benchmarkFetch(b, hgnc, h.FIELD_VEGA_ID, "OTTHUMG%011d")
+}
+
+// BenchmarkFetchAlias fetches by alias, which Fetch normalizes to the symbol first
+// (see BenchmarkNormalizeSymbol for normalization alone).
+func BenchmarkFetchAlias(b *testing.B) {
+	benchmarkFetch(b, benchHGNC(), h.FIELD_SYMBOL, "ALIAS%d")
+}
+
+func BenchmarkLookupSymbolToEntrez(b *testing.B) {
+	hgnc := benchHGNC()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hgnc.SymbolToEntrezID(fmt.Sprintf("GENE%d", i%databaseSize))
+	}
+}
+
+func benchmarkBatchFetch(b *testing.B, size int) {
+	hgnc := benchHGNC()
+	symbols := make([]string, size)
+	for i := range symbols {
+		symbols[i] = fmt.Sprintf("ALIAS%d", i%databaseSize)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hgnc.BatchFetch(symbols, h.FIELD_SYMBOL)
+	}
+}
+
+func BenchmarkBatchFetch1000(b *testing.B) {
+	benchmarkBatchFetch(b, 1000)
+}
+
+func BenchmarkBatchFetch10000(b *testing.B) {
+	benchmarkBatchFetch(b, 10000)
+}
+
+// BenchmarkLoadTsv parses an export of the synthetic database, from memory to
+// leave disk reads out.
+func BenchmarkLoadTsv(b *testing.B) {
+	var tsv bytes.Buffer
+	if err := benchHGNC().ExportAllToTSV(&tsv, h.AllFields()); err != nil {
+		b.Fatal(err)
+	}
+	data := tsv.Bytes()
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := h.LoadFromReader(bytes.NewReader(data), h.WithLogger(benchLogger)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	hgnc := benchHGNC()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		hgnc.Filter(func(r *h.Record) bool {
+			return r.LocusGroupEnum() == h.LocusGroupProteinCoding
+		})
+	}
+}
+
+// parallelisms are the goroutine counts of concurrent benchmarks, multiplied by
+// GOMAXPROCS as b.SetParallelism does (run with -cpu 1 for exact counts).
+var parallelisms = []int{1, 4, 8, 16}
+
+// runParallel runs fn as sub-benchmarks for each of parallelisms, fn is called with
+// a counter unique to the goroutine.
+func runParallel(b *testing.B, fn func(i int)) {
+	for _, p := range parallelisms {
+		b.Run(fmt.Sprintf("goroutines=%d", p), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetParallelism(p)
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					fn(i)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkFetchConcurrent(b *testing.B) {
+	hgnc := benchHGNC()
+	runParallel(b, func(i int) {
+		hgnc.Fetch(fmt.Sprintf("GENE%d", i%databaseSize), h.FIELD_SYMBOL)
+	})
+}
+
+// BenchmarkAddIndexConcurrent measures contention of EnsureIndex on an index built
+// by the first call, like concurrent Fetch calls on a lazily indexed field.
+func BenchmarkAddIndexConcurrent(b *testing.B) {
+	hgnc := benchHGNC()
+	runParallel(b, func(int) {
+		hgnc.EnsureIndex(h.FIELD_LOCATION)
+	})
+}
@@ -0,0 +1,115 @@
+package hgnc_go
+
+import "strings"
+
+// RecordBuilder constructs a Record field by field, e.g. for test fixtures or
+// synthetic records to merge:
+//
+//	record := NewRecordBuilder().
+//		WithHgncID("HGNC:11998").
+//		WithSymbol("TP53").
+//		WithEntrezID("7157").
+//		WithLocusGroup(LocusGroupProteinCoding).
+//		Build()
+type RecordBuilder struct {
+	data map[Field]string
+}
+
+// NewRecordBuilder returns an empty RecordBuilder.
+func NewRecordBuilder() *RecordBuilder {
+	return &RecordBuilder{data: make(map[Field]string)}
+}
+
+// With sets any field.
+func (b *RecordBuilder) With(field Field, value string) *RecordBuilder {
+	b.data[field] = value
+	return b
+}
+
+// WithHgncID sets FIELD_HGNC_ID.
+func (b *RecordBuilder) WithHgncID(hgncID string) *RecordBuilder {
+	return b.With(FIELD_HGNC_ID, hgncID)
+}
+
+// WithSymbol sets FIELD_SYMBOL.
+func (b *RecordBuilder) WithSymbol(symbol string) *RecordBuilder {
+	return b.With(FIELD_SYMBOL, symbol)
+}
+
+// WithName sets FIELD_NAME.
+func (b *RecordBuilder) WithName(name string) *RecordBuilder {
+	return b.With(FIELD_NAME, name)
+}
+
+// WithLocusGroup sets FIELD_LOCUS_GROUP.
+func (b *RecordBuilder) WithLocusGroup(locusGroup LocusGroup) *RecordBuilder {
+	return b.With(FIELD_LOCUS_GROUP, string(locusGroup))
+}
+
+// WithLocusType sets FIELD_LOCUS_TYPE.
+func (b *RecordBuilder) WithLocusType(locusType LocusType) *RecordBuilder {
+	return b.With(FIELD_LOCUS_TYPE, string(locusType))
+}
+
+// WithStatus sets FIELD_STATUS.
+func (b *RecordBuilder) WithStatus(status GeneStatus) *RecordBuilder {
+	return b.With(FIELD_STATUS, string(status))
+}
+
+// WithLocation sets FIELD_LOCATION and FIELD_LOCATION_SORTABLE.
+func (b *RecordBuilder) WithLocation(location string) *RecordBuilder {
+	b.With(FIELD_LOCATION_SORTABLE, location)
+	return b.With(FIELD_LOCATION, location)
+}
+
+// WithAliasSymbols sets FIELD_ALIAS_SYMBOL, joined with "|".
+func (b *RecordBuilder) WithAliasSymbols(symbols ...string) *RecordBuilder {
+	return b.With(FIELD_ALIAS_SYMBOL, strings.Join(symbols, multiValueDelimiter))
+}
+
+// WithPrevSymbols sets FIELD_PREV_SYMBOL, joined with "|".
+func (b *RecordBuilder) WithPrevSymbols(symbols ...string) *RecordBuilder {
+	return b.With(FIELD_PREV_SYMBOL, strings.Join(symbols, multiValueDelimiter))
+}
+
+// WithEntrezID sets FIELD_ENTREZ_ID.
+func (b *RecordBuilder) WithEntrezID(entrezID string) *RecordBuilder {
+	return b.With(FIELD_ENTREZ_ID, entrezID)
+}
+
+// WithEnsemblGeneID sets FIELD_ENSEMBL_GENE_ID.
+func (b *RecordBuilder) WithEnsemblGeneID(ensg string) *RecordBuilder {
+	return b.With(FIELD_ENSEMBL_GENE_ID, ensg)
+}
+
+// WithUcscID sets FIELD_UCSC_ID.
+func (b *RecordBuilder) WithUcscID(ucscID string) *RecordBuilder {
+	return b.With(FIELD_UCSC_ID, ucscID)
+}
+
+// WithRefseqAccession sets FIELD_REFSEQ_ACCESSION.
+func (b *RecordBuilder) WithRefseqAccession(accession string) *RecordBuilder {
+	return b.With(FIELD_REFSEQ_ACCESSION, accession)
+}
+
+// WithOmimID sets FIELD_OMIM_ID.
+func (b *RecordBuilder) WithOmimID(omimID string) *RecordBuilder {
+	return b.With(FIELD_OMIM_ID, omimID)
+}
+
+// WithManeSelect sets FIELD_MANE_SELECT from the Ensembl and RefSeq transcript ids.
+func (b *RecordBuilder) WithManeSelect(enst, refseq string) *RecordBuilder {
+	return b.With(FIELD_MANE_SELECT, enst+multiValueDelimiter+refseq)
+}
+
+// Build returns the Record, fields not set are empty. The builder can be reused,
+// later changes don't affect records already built.
+func (b *RecordBuilder) Build() *Record {
+	record := NewRecord(b.data)
+	for field := range fieldDesc {
+		if _, ok := record.data[field]; !ok {
+			record.data[field] = ""
+		}
+	}
+	return record
+}
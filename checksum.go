@@ -0,0 +1,95 @@
+package hgnc_go
+
+import (
+	"bytes"
+	"crypto"
+	_ "crypto/md5" // register hash implementations for WithChecksum
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"slices"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned when data doesn't match the expected checksum.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// checksum is the expected digest of the loaded data, set by WithChecksum.
+type checksum struct {
+	expected  []byte
+	algorithm crypto.Hash
+}
+
+// newHash creates the hash of the algorithm, or an error if it is not available.
+func (c *checksum) newHash() (hash.Hash, error) {
+	if !c.algorithm.Available() {
+		return nil, fmt.Errorf("checksum algorithm %v is not available", c.algorithm)
+	}
+	return c.algorithm.New(), nil
+}
+
+// verify compares the digest of a finished hash with the expected one.
+func (c *checksum) verify(h hash.Hash) error {
+	if actual := h.Sum(nil); !bytes.Equal(actual, c.expected) {
+		return fmt.Errorf("%w: expected %v %x, got %x", ErrChecksumMismatch, c.algorithm, c.expected, actual)
+	}
+	return nil
+}
+
+// verifyFile checks the checksum of the whole file and rewinds it for parsing.
+func (c *checksum) verifyFile(fh *os.File) error {
+	h, err := c.newHash()
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(h, fh); err != nil {
+		return err
+	}
+	if err := c.verify(h); err != nil {
+		return fmt.Errorf("%s: %w", fh.Name(), err)
+	}
+	_, err = fh.Seek(0, io.SeekStart)
+	return err
+}
+
+// LoadTsvWithChecksums is like LoadTsv, but first checks the data file against a
+// companion checksum file, e.g. "hgnc_complete_set.txt.gz.md5". The checksum file holds
+// a hex digest, optionally followed by the file name (output of md5sum / sha256sum).
+// MD5, SHA-1, SHA-256 and SHA-512 are recognized by the digest length.
+func LoadTsvWithChecksums(filepath, checksumFile string, gzipped bool, opts ...LoadOption) (*HGNC, error) {
+
+	content, err := os.ReadFile(checksumFile)
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%s: empty checksum file", checksumFile)
+	}
+	expected, err := hex.DecodeString(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid hex digest: %w", checksumFile, err)
+	}
+
+	var algorithm crypto.Hash
+	switch len(expected) {
+	case crypto.MD5.Size():
+		algorithm = crypto.MD5
+	case crypto.SHA1.Size():
+		algorithm = crypto.SHA1
+	case crypto.SHA256.Size():
+		algorithm = crypto.SHA256
+	case crypto.SHA512.Size():
+		algorithm = crypto.SHA512
+	default:
+		return nil, fmt.Errorf("%s: unknown checksum algorithm for a %d-byte digest", checksumFile, len(expected))
+	}
+
+	return LoadTsv(filepath, gzipped, append(slices.Clip(opts), WithChecksum(expected, algorithm))...)
+}
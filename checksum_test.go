@@ -0,0 +1,67 @@
+package hgnc_go_test
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+)
+
+const checksumTSV = "hgnc_id\tsymbol\tname\n" +
+	"HGNC:11998\tTP53\ttumor protein p53\n"
+
+func TestLoadTsvWithChecksums(t *testing.T) {
+
+	md5Sum := md5.Sum([]byte(checksumTSV))
+	sha256Sum := sha256.Sum256([]byte(checksumTSV))
+	otherSum := sha256.Sum256([]byte("other"))
+
+	tests := []struct {
+		name     string
+		checksum string
+		wantErr  bool
+		mismatch bool
+	}{
+		{"md5", hex.EncodeToString(md5Sum[:]), false, false},
+		{"sha256 with file name", hex.EncodeToString(sha256Sum[:]) + "  hgnc.tsv\n", false, false},
+		{"mismatch", hex.EncodeToString(otherSum[:]), true, true},
+		{"empty file", "", true, false},
+		{"invalid hex", "not a digest", true, false},
+		{"unknown algorithm", "abcd", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "hgnc.tsv")
+			if err := os.WriteFile(path, []byte(checksumTSV), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			checksumFile := path + ".sum"
+			if err := os.WriteFile(checksumFile, []byte(tt.checksum), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			// spare capacity, so an append in LoadTsvWithChecksums could write into it
+			opts := make([]h.LoadOption, 1, 2)
+			opts[0] = h.WithDelimiter('\t')
+			hgnc, err := h.LoadTsvWithChecksums(path, checksumFile, false, opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadTsvWithChecksums() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := errors.Is(err, h.ErrChecksumMismatch); got != tt.mismatch {
+				t.Errorf("errors.Is(err, ErrChecksumMismatch) = %v, want %v", got, tt.mismatch)
+			}
+			if err == nil && hgnc.RecordCount() != 1 {
+				t.Errorf("loaded %d records, want 1", hgnc.RecordCount())
+			}
+			if opts[:2][1] != nil {
+				t.Error("LoadTsvWithChecksums modified the backing array of the caller's options")
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package hgnc_go
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+var _ io.Closer = (*HGNC)(nil)
+
+// ErrClosed is returned when a background task (e.g. WatchFile) is started after Close.
+var ErrClosed = errors.New("HGNC is closed")
+
+// background tracks background tasks of an HGNC (e.g. WatchFile) so Close can stop them.
+type background struct {
+	mu     sync.Mutex
+	ctx    context.Context    // canceled by Close, nil until the first task or Close
+	cancel context.CancelFunc // cancels ctx
+	closed bool               // set by Close, no task is started after it
+	wg     sync.WaitGroup     // running tasks
+}
+
+// context returns the background context, creating it if needed. b.mu must be held.
+func (b *background) context() context.Context {
+	if b.ctx == nil {
+		b.ctx, b.cancel = context.WithCancel(context.Background())
+	}
+	return b.ctx
+}
+
+// startTask registers a background task and derives its context from ctx, canceled also by Close.
+// The returned done function must be called when the task exits. It returns ErrClosed after Close.
+func (h *HGNC) startTask(ctx context.Context) (context.Context, func(), error) {
+
+	h.bg.mu.Lock()
+	if h.bg.closed {
+		h.bg.mu.Unlock()
+		return nil, nil, ErrClosed
+	}
+	bgCtx := h.bg.context()
+	h.bg.wg.Add(1) // before Close can Wait, closed is checked under the same lock
+	h.bg.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(bgCtx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+		h.bg.wg.Done()
+	}, nil
+}
+
+// Close stops background tasks started on h (e.g. WatchFile) and waits for them to exit.
+// Tasks can't be started after Close, WatchFile returns ErrClosed. For a database without
+// background tasks it does nothing. The database stays usable for queries. Close always
+// returns nil.
+//
+// Close must not be called from a task's callback (e.g. the onChange of WatchFile),
+// it would wait for the task calling it and never return.
+func (h *HGNC) Close() error {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.bg.mu.Lock()
+	h.bg.closed = true
+	h.bg.context()
+	h.bg.cancel()
+	h.bg.mu.Unlock()
+
+	h.bg.wg.Wait()
+	return nil
+}
@@ -0,0 +1,80 @@
+package hgnc_go
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newWatchedFile writes a small TSV file and loads it.
+func newWatchedFile(t *testing.T) (*HGNC, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "hgnc.tsv")
+	if err := os.WriteFile(path, []byte(watchHeader+watchTP53), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	h, err := LoadTsv(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h, path
+}
+
+func TestClose(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		watchers int
+		closeAt  time.Duration // after starting the watchers
+	}{
+		{"no tasks", 0, 0},
+		{"running watcher", 1, 20 * time.Millisecond},
+		{"watchers racing close", 16, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, path := newWatchedFile(t)
+
+			errs := make(chan error, tt.watchers)
+			var started sync.WaitGroup
+			for i := 0; i < tt.watchers; i++ {
+				started.Add(1)
+				go func() {
+					started.Done()
+					errs <- h.WatchFile(context.Background(), path, false, func(*HGNC, error) {})
+				}()
+			}
+			started.Wait()
+			time.Sleep(tt.closeAt)
+
+			if err := h.Close(); err != nil {
+				t.Fatalf("Close() = %v", err)
+			}
+			for i := 0; i < tt.watchers; i++ {
+				select {
+				case err := <-errs:
+					// canceled if it was running, ErrClosed if it started after Close
+					if !errors.Is(err, context.Canceled) && !errors.Is(err, ErrClosed) {
+						t.Errorf("WatchFile returned %v, want context.Canceled or ErrClosed", err)
+					}
+				case <-time.After(5 * time.Second):
+					t.Fatal("WatchFile didn't return after Close")
+				}
+			}
+
+			if got := len(h.Fetch("TP53", FIELD_SYMBOL)); got != 1 {
+				t.Errorf("Fetch after Close returned %d records, want 1", got)
+			}
+			if err := h.WatchFile(context.Background(), path, false, func(*HGNC, error) {}); !errors.Is(err, ErrClosed) {
+				t.Errorf("WatchFile after Close returned %v, want ErrClosed", err)
+			}
+			if err := h.Close(); err != nil {
+				t.Errorf("second Close() = %v", err)
+			}
+		})
+	}
+}
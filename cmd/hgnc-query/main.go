@@ -0,0 +1,137 @@
+/*
+hgnc-query looks up genes in an HGNC complete set file from the command line.
+
+	# single query
+	go run ./cmd/hgnc-query --db data/hgnc_complete_set.txt.gz --query entrez_id=7157 --format markdown
+
+	# batch query, one identifier per line
+	cut -f1 genes.txt | go run ./cmd/hgnc-query --db data/hgnc_complete_set.txt.gz --query symbol --stdin
+
+Exit code is 1 if any queried identifier produced no results.
+*/
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	h "github.com/viktorxia/hgnc-go"
+)
+
+func main() {
+
+	dbPath := flag.String("db", "data/hgnc_complete_set.txt.gz", "path to HGNC complete set file (.txt or .txt.gz)")
+	query := flag.String("query", "", "query as field=value, e.g. entrez_id=7157; with --stdin only the field, e.g. symbol")
+	fieldsStr := flag.String("fields", "hgnc_id,symbol,name,entrez_id", "comma-separated fields to output")
+	format := flag.String("format", "tsv", "output format: tsv, json or markdown")
+	fromStdin := flag.Bool("stdin", false, "read identifiers from standard input, one per line")
+	flag.Parse()
+
+	// query field & values
+	queryField, queryValue, _ := strings.Cut(*query, "=")
+	var values []string
+	if *fromStdin {
+		if queryField == "" {
+			queryField = string(h.FIELD_SYMBOL)
+		}
+		var err error
+		if values, err = readValues(os.Stdin); err != nil {
+			fatalf("failed to read standard input: %v", err)
+		}
+	} else {
+		if queryField == "" || queryValue == "" {
+			fatalf("--query must be field=value, got %q", *query)
+		}
+		values = []string{queryValue}
+	}
+	if !slices.Contains(h.AllFieldNames(), queryField) {
+		fatalf("unknown --query field %q", queryField)
+	}
+	fields, err := parseFields(*fieldsStr)
+	if err != nil {
+		fatalf("--fields: %v", err)
+	}
+
+	hgnc, err := h.LoadTsv(*dbPath, strings.HasSuffix(*dbPath, ".gz"))
+	if err != nil {
+		fatalf("failed to load HGNC data: %v", err)
+	}
+
+	// fetch, keeping input order
+	found := hgnc.BatchFetch(values, h.Field(queryField))
+	var records []*h.Record
+	missing := 0
+	for _, value := range values {
+		if len(found[value]) == 0 {
+			fmt.Fprintf(os.Stderr, "hgnc-query: no result for %s=%s\n", queryField, value)
+			missing++
+			continue
+		}
+		records = append(records, found[value]...)
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	if err := write(out, hgnc, records, fields, *format); err != nil {
+		fatalf("%v", err)
+	}
+	if err := out.Flush(); err != nil {
+		fatalf("%v", err)
+	}
+
+	if missing > 0 {
+		os.Exit(1)
+	}
+}
+
+// readValues reads non-empty lines from r.
+func readValues(r io.Reader) ([]string, error) {
+	var values []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if value := strings.TrimSpace(scanner.Text()); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values, scanner.Err()
+}
+
+// parseFields parses a comma-separated field list, fields must be named as in the HGNC file.
+func parseFields(s string) ([]h.Field, error) {
+	names := h.AllFieldNames()
+	var fields []h.Field
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f == "" {
+			continue
+		}
+		if !slices.Contains(names, f) {
+			return nil, fmt.Errorf("unknown field %q", f)
+		}
+		fields = append(fields, h.Field(f))
+	}
+	return fields, nil
+}
+
+// write outputs records in the given format.
+func write(w io.Writer, hgnc *h.HGNC, records []*h.Record, fields []h.Field, format string) error {
+	switch format {
+	case "tsv":
+		return hgnc.ExportToTSV(w, records, fields)
+	case "json":
+		return hgnc.ExportToJSON(w, records)
+	case "markdown", "md":
+		return h.ExportToMarkdownTable(w, records, fields)
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "hgnc-query: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestParseFields(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		s       string
+		want    []h.Field
+		wantErr bool
+	}{
+		{"fields", "hgnc_id,symbol", []h.Field{h.FIELD_HGNC_ID, h.FIELD_SYMBOL}, false},
+		{"spaces and empty entries", " symbol , ,entrez_id,", []h.Field{h.FIELD_SYMBOL, h.FIELD_ENTREZ_ID}, false},
+		{"empty", "", nil, false},
+		{"unknown field", "symbol,entrez", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFields(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseFields(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("parseFields(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadValues(t *testing.T) {
+
+	got, err := readValues(strings.NewReader("TP53\n\n  BRCA1 \r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"TP53", "BRCA1"}; !slices.Equal(got, want) {
+		t.Errorf("readValues() = %q, want %q", got, want)
+	}
+}
+
+func TestWrite(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53())
+	records := hgnc.Fetch("TP53", h.FIELD_SYMBOL)
+	fields := []h.Field{h.FIELD_SYMBOL, h.FIELD_ENTREZ_ID}
+
+	tests := []struct {
+		format  string
+		want    string
+		wantErr bool
+	}{
+		{"tsv", "TP53\t7157", false},
+		{"json", `"entrez_id":"7157"`, false},
+		{"markdown", "| TP53", false},
+		{"md", "| TP53", false},
+		{"xml", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var out strings.Builder
+			err := write(&out, hgnc, records, fields, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("write(%q) error = %v, wantErr %v", tt.format, err, tt.wantErr)
+			}
+			if !strings.Contains(out.String(), tt.want) {
+				t.Errorf("write(%q) output %q doesn't contain %q", tt.format, out.String(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+hgnc-server exposes an HGNC complete set file as JSON endpoints.
+
+	go run ./cmd/hgnc-server --db data/hgnc_complete_set.txt.gz --addr :8080
+
+	GET /fetch?value=TP53&field=symbol                -> array of records
+	GET /lookup?value=TP53&query=symbol&target=entrez_id -> array of strings
+	GET /stats                                        -> database stats
+
+Fields are named as in the HGNC file, unknown fields are a 400 Bad Request.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	h "github.com/viktorxia/hgnc-go"
+)
+
+func main() {
+
+	dbPath := flag.String("db", "data/hgnc_complete_set.txt.gz", "path to HGNC complete set file (.txt or .txt.gz)")
+	addr := flag.String("addr", ":8080", "listen address")
+	flag.Parse()
+
+	hgnc, err := h.LoadTsv(*dbPath, strings.HasSuffix(*dbPath, ".gz"))
+	if err != nil {
+		log.Fatalf("Failed to load HGNC data: %v", err)
+	}
+	log.Printf("Loaded %d records from %s", hgnc.RecordCount(), *dbPath)
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           newHandler(hgnc),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// graceful shutdown on SIGINT/SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Shutdown: %v", err)
+		}
+	}()
+
+	log.Printf("Listening on %s", *addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("Server: %v", err)
+	}
+	log.Println("Server stopped")
+}
+
+// newHandler routes requests to the HGNC database.
+func newHandler(hgnc *h.HGNC) http.Handler {
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /fetch", func(w http.ResponseWriter, r *http.Request) {
+		value, field := r.URL.Query().Get("value"), r.URL.Query().Get("field")
+		if value == "" || field == "" {
+			writeError(w, http.StatusBadRequest, "value and field are required")
+			return
+		}
+		if name, ok := unknownField(field); !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown field %q", name))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := hgnc.ExportToJSON(w, hgnc.Fetch(value, h.Field(field))); err != nil {
+			log.Printf("GET /fetch: %v", err)
+		}
+	})
+
+	mux.HandleFunc("GET /lookup", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		value, query, target := q.Get("value"), q.Get("query"), q.Get("target")
+		if value == "" || query == "" || target == "" {
+			writeError(w, http.StatusBadRequest, "value, query and target are required")
+			return
+		}
+		if name, ok := unknownField(query, target); !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown field %q", name))
+			return
+		}
+		results := hgnc.Lookup(value, h.Field(query), h.Field(target))
+		if results == nil {
+			// an empty array rather than null
+			results = []string{}
+		}
+		writeJSON(w, results)
+	})
+
+	mux.HandleFunc("GET /stats", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, hgnc.Stats())
+	})
+
+	return mux
+}
+
+// unknownField returns the first of names that is not a field of the HGNC file, ok is
+// false if there is one.
+func unknownField(names ...string) (name string, ok bool) {
+	fields := h.AllFieldNames()
+	for _, name := range names {
+		if !slices.Contains(fields, name) {
+			return name, false
+		}
+	}
+	return "", true
+}
+
+// writeJSON streams v as JSON.
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("Encoding response: %v", err)
+	}
+}
+
+// writeError writes a JSON error message with the status code.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestHandler(t *testing.T) {
+
+	handler := newHandler(testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1()))
+
+	tests := []struct {
+		name       string
+		url        string
+		wantStatus int
+		wantBody   string
+	}{
+		{"fetch", "/fetch?value=TP53&field=symbol", http.StatusOK, `"hgnc_id":"HGNC:11998"`},
+		{"fetch not found", "/fetch?value=NOPE&field=symbol", http.StatusOK, "[]\n"},
+		{"fetch missing field", "/fetch?value=TP53", http.StatusBadRequest, "required"},
+		{"fetch unknown field", "/fetch?value=TP53&field=symbl", http.StatusBadRequest, `unknown field \"symbl\"`},
+		{"lookup", "/lookup?value=TP53&query=symbol&target=entrez_id", http.StatusOK, `["7157"]`},
+		{"lookup not found", "/lookup?value=NOPE&query=symbol&target=entrez_id", http.StatusOK, "[]\n"},
+		{"lookup unknown query", "/lookup?value=TP53&query=symbl&target=entrez_id", http.StatusBadRequest, `unknown field \"symbl\"`},
+		{"lookup unknown target", "/lookup?value=TP53&query=symbol&target=entrez", http.StatusBadRequest, `unknown field \"entrez\"`},
+		{"stats", "/stats", http.StatusOK, "{"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest("GET", tt.url, nil))
+			if rec.Code != tt.wantStatus {
+				t.Errorf("GET %s: status %d, want %d", tt.url, rec.Code, tt.wantStatus)
+			}
+			if body := rec.Body.String(); !strings.Contains(body, tt.wantBody) || strings.Contains(body, "null") {
+				t.Errorf("GET %s: body %q, want it to contain %q and no null", tt.url, body, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package hgnc_go
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// SymbolDiff describes differences between local and live records of a symbol.
+// LocalRecord or LiveRecord is nil if the symbol is not found there.
+type SymbolDiff struct {
+	Symbol      string
+	LocalRecord *Record
+	LiveRecord  *Record
+	DiffFields  []Field
+}
+
+// compareWorkers is the number of concurrent REST requests in CompareWithREST,
+// the client's rate limiter still applies.
+const compareWorkers = 4
+
+// CompareWithREST compares local records of symbols with the live HGNC REST API
+// and returns diffs for symbols where any known field differs. Symbols are normalized
+// locally first, so aliases are compared by their approved symbol.
+func (h *HGNC) CompareWithREST(client *RESTClient, symbols []string) ([]SymbolDiff, error) {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	diffs := make([]*SymbolDiff, len(symbols))
+	errs := make([]error, len(symbols))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(compareWorkers, len(symbols)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				diffs[i], errs[i] = h.compareSymbolWithREST(client, symbols[i])
+			}
+		}()
+	}
+	for i := range symbols {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	results := make([]SymbolDiff, 0)
+	for _, diff := range diffs {
+		if diff != nil {
+			results = append(results, *diff)
+		}
+	}
+	return results, nil
+}
+
+// compareSymbolWithREST compares a single symbol, returns nil if local and live records are equal.
+func (h *HGNC) compareSymbolWithREST(client *RESTClient, symbol string) (*SymbolDiff, error) {
+
+	var local *Record
+	if records := h.Fetch(symbol, FIELD_SYMBOL); len(records) > 0 {
+		local = records[0]
+		symbol = local.Symbol()
+	}
+
+	live, err := client.FetchBySymbol(symbol)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+
+	if local == nil && live == nil {
+		return nil, nil
+	}
+
+	fields := diffKnownFields(local, live)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return &SymbolDiff{
+		Symbol:      symbol,
+		LocalRecord: local,
+		LiveRecord:  live,
+		DiffFields:  fields,
+	}, nil
+}
+
+// diffKnownFields returns known fields (those with a Field constant) whose values
+// differ between two records, sorted by name. A nil record has all fields empty.
+func diffKnownFields(a, b *Record) []Field {
+
+	var fields []Field
+	for field := range fieldDesc {
+		if a.Get(field) != b.Get(field) {
+			fields = append(fields, field)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i] < fields[j]
+	})
+	return fields
+}
+
+// RecordChange describes a record present in both snapshots with different field values.
+type RecordChange struct {
+	HgncID        string
+	OldRecord     *Record
+	NewRecord     *Record
+	ChangedFields []Field
+}
+
+// HGNCDiff is the record-level difference between two database snapshots,
+// records are matched by HGNC ID.
+type HGNCDiff struct {
+	Added   []*Record      // in new, not in old
+	Removed []*Record      // in old, not in new
+	Changed []RecordChange // in both, with different values
+}
+
+// Diff computes record-level differences between old and new snapshots in O(n).
+// Records without an HGNC ID are ignored. Added and Changed follow the order
+// of new, Removed follows the order of old.
+func Diff(old, new *HGNC) *HGNCDiff {
+
+	if old == nil || new == nil {
+		panic("HGNC is nil")
+	}
+
+	defer rlockPair(old, new)()
+
+	oldByID := recordsByHgncID(old.records)
+	newByID := recordsByHgncID(new.records)
+
+	diff := &HGNCDiff{
+		Added:   make([]*Record, 0),
+		Removed: make([]*Record, 0),
+		Changed: make([]RecordChange, 0),
+	}
+
+	for _, record := range new.records {
+		hgncID := record.data[FIELD_HGNC_ID]
+		if newByID[hgncID] != record {
+			// no HGNC ID, or duplicated
+			continue
+		}
+		oldRecord, ok := oldByID[hgncID]
+		if !ok {
+			diff.Added = append(diff.Added, record)
+			continue
+		}
+		if fields := diffFields(oldRecord, record); len(fields) > 0 {
+			diff.Changed = append(diff.Changed, RecordChange{
+				HgncID:        hgncID,
+				OldRecord:     oldRecord,
+				NewRecord:     record,
+				ChangedFields: fields,
+			})
+		}
+	}
+
+	for _, record := range old.records {
+		hgncID := record.data[FIELD_HGNC_ID]
+		if oldByID[hgncID] != record {
+			continue
+		}
+		if _, ok := newByID[hgncID]; !ok {
+			diff.Removed = append(diff.Removed, record)
+		}
+	}
+
+	return diff
+}
+
+// recordsByHgncID indexes records by HGNC ID, the first record wins for duplicates.
+func recordsByHgncID(records []*Record) map[string]*Record {
+	byID := make(map[string]*Record, len(records))
+	for _, record := range records {
+		hgncID := record.data[FIELD_HGNC_ID]
+		if hgncID == "" {
+			continue
+		}
+		if _, ok := byID[hgncID]; !ok {
+			byID[hgncID] = record
+		}
+	}
+	return byID
+}
+
+// diffFields returns fields whose values differ between two records, sorted by name.
+// All fields of either record are compared, a missing field is the same as an empty one.
+func diffFields(a, b *Record) []Field {
+
+	var fields []Field
+	for field, value := range a.data {
+		if value != b.data[field] {
+			fields = append(fields, field)
+		}
+	}
+	for field, value := range b.data {
+		if _, ok := a.data[field]; !ok && value != "" {
+			fields = append(fields, field)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i] < fields[j]
+	})
+	return fields
+}
+
+// FieldChange is a field whose value differs between two records.
+type FieldChange struct {
+	Field    Field
+	OldValue string
+	NewValue string
+}
+
+// CompareFields returns fields that differ between the Record (old) and other (new),
+// sorted by field name. A field missing from one record is the same as an empty one.
+func (r *Record) CompareFields(other *Record) []FieldChange {
+
+	empty := &Record{}
+	if r == nil {
+		r = empty
+	}
+	if other == nil {
+		other = empty
+	}
+
+	fields := diffFields(r, other)
+	changes := make([]FieldChange, 0, len(fields))
+	for _, field := range fields {
+		changes = append(changes, FieldChange{
+			Field:    field,
+			OldValue: r.data[field],
+			NewValue: other.data[field],
+		})
+	}
+	return changes
+}
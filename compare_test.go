@@ -0,0 +1,224 @@
+package hgnc_go_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestDiff(t *testing.T) {
+
+	renamed := h.NewRecordBuilder().WithHgncID("HGNC:11998").WithSymbol("TP53").WithName("renamed").Build()
+	noID := h.NewRecordBuilder().WithSymbol("NOID").Build()
+
+	tests := []struct {
+		name        string
+		old, new    []*h.Record
+		wantAdded   []string
+		wantRemoved []string
+		wantChanged map[string][]h.Field
+	}{
+		{
+			"identical",
+			[]*h.Record{testutil.FixtureTP53()},
+			[]*h.Record{testutil.FixtureTP53()},
+			nil, nil, nil,
+		},
+		{
+			"added and removed",
+			[]*h.Record{testutil.FixtureTP53(), testutil.FixtureBRCA1()},
+			[]*h.Record{testutil.FixtureTP53(), testutil.FixtureMIR21()},
+			[]string{"HGNC:31586"}, []string{"HGNC:1100"}, nil,
+		},
+		{
+			"changed",
+			[]*h.Record{h.NewRecordBuilder().WithHgncID("HGNC:11998").WithSymbol("TP53").WithName("tumor protein p53").Build()},
+			[]*h.Record{renamed},
+			nil, nil, map[string][]h.Field{"HGNC:11998": {h.FIELD_NAME}},
+		},
+		{
+			"records without hgnc id are ignored",
+			[]*h.Record{noID},
+			[]*h.Record{},
+			nil, nil, nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := h.Diff(testutil.NewMockHGNC(tt.old...), testutil.NewMockHGNC(tt.new...))
+
+			ids := func(records []*h.Record) []string {
+				var result []string
+				for _, record := range records {
+					result = append(result, record.Get(h.FIELD_HGNC_ID))
+				}
+				return result
+			}
+			if got := ids(diff.Added); !slices.Equal(got, tt.wantAdded) {
+				t.Errorf("Added = %v, want %v", got, tt.wantAdded)
+			}
+			if got := ids(diff.Removed); !slices.Equal(got, tt.wantRemoved) {
+				t.Errorf("Removed = %v, want %v", got, tt.wantRemoved)
+			}
+			if len(diff.Changed) != len(tt.wantChanged) {
+				t.Fatalf("Changed = %v, want %v", diff.Changed, tt.wantChanged)
+			}
+			for _, change := range diff.Changed {
+				if want := tt.wantChanged[change.HgncID]; !slices.Equal(change.ChangedFields, want) {
+					t.Errorf("ChangedFields of %s = %v, want %v", change.HgncID, change.ChangedFields, want)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeDiffConcurrent(t *testing.T) {
+
+	a := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1())
+	b := testutil.NewMockHGNC(testutil.FixtureBRCA1(), testutil.FixtureMIR21())
+
+	runConcurrently(t, a, b,
+		func() { h.Merge(a, b) },
+		func() { h.Diff(b, a) },
+		func() { h.Diff(a, b) },
+	)
+}
+
+// newRESTServer serves /fetch/symbol/{symbol} of the HGNC REST API from records,
+// symbols in fail get a 500 response.
+func newRESTServer(t *testing.T, records []*h.Record, fail ...string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		symbol, ok := strings.CutPrefix(r.URL.Path, "/fetch/symbol/")
+		if !ok || slices.Contains(fail, symbol) {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+			return
+		}
+		docs := make([]json.RawMessage, 0)
+		for _, record := range records {
+			if record.Symbol() == symbol {
+				doc, err := record.MarshalHGNCJSON()
+				if err != nil {
+					t.Error(err)
+				}
+				docs = append(docs, doc)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"response": map[string]any{"numFound": len(docs), "docs": docs},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCompareWithREST(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1())
+	renamed := h.NewRecordBuilder().WithHgncID("HGNC:1100").WithSymbol("BRCA1").WithName("renamed").Build()
+	live := []*h.Record{testutil.FixtureTP53(), renamed, testutil.FixtureMIR21()}
+	client := h.NewRESTClient(newRESTServer(t, live, "FAIL").URL, h.WithRateLimit(0))
+
+	tests := []struct {
+		name    string
+		symbols []string
+		want    map[string]bool // symbols with a diff -> whether the local record exists
+		wantErr bool
+	}{
+		{"equal", []string{"TP53"}, map[string]bool{}, false},
+		{"alias of an equal record", []string{"p53"}, map[string]bool{}, false},
+		{"changed", []string{"BRCA1"}, map[string]bool{"BRCA1": true}, false},
+		{"alias of a changed record", []string{"RNF53"}, map[string]bool{"BRCA1": true}, false},
+		{"only live", []string{"MIR21"}, map[string]bool{"MIR21": false}, false},
+		{"nowhere", []string{"NOPE"}, map[string]bool{}, false},
+		{"several", []string{"TP53", "BRCA1", "MIR21", "NOPE"}, map[string]bool{"BRCA1": true, "MIR21": false}, false},
+		{"server error", []string{"TP53", "FAIL"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs, err := hgnc.CompareWithREST(client, tt.symbols)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CompareWithREST() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(diffs) != len(tt.want) {
+				t.Fatalf("CompareWithREST() returned %d diffs, want %d: %+v", len(diffs), len(tt.want), diffs)
+			}
+			for _, diff := range diffs {
+				hasLocal, ok := tt.want[diff.Symbol]
+				if !ok {
+					t.Errorf("unexpected diff of %s", diff.Symbol)
+					continue
+				}
+				if (diff.LocalRecord != nil) != hasLocal || diff.LiveRecord == nil {
+					t.Errorf("diff of %s: local %v, live %v", diff.Symbol, diff.LocalRecord != nil, diff.LiveRecord != nil)
+				}
+				if !slices.Contains(diff.DiffFields, h.FIELD_NAME) {
+					t.Errorf("diff of %s: fields %v don't include name", diff.Symbol, diff.DiffFields)
+				}
+			}
+		})
+	}
+}
+
+func TestCompareFields(t *testing.T) {
+
+	old := h.NewRecordBuilder().WithHgncID("HGNC:11998").WithSymbol("TP53").WithName("tumor protein p53").Build()
+
+	tests := []struct {
+		name     string
+		old, new *h.Record
+		want     []h.FieldChange
+	}{
+		{"identical", testutil.FixtureTP53(), testutil.FixtureTP53(), nil},
+		{
+			"changed and added",
+			old,
+			h.NewRecordBuilder().WithHgncID("HGNC:11998").WithSymbol("TP53").WithName("renamed").WithEntrezID("7157").Build(),
+			[]h.FieldChange{
+				{Field: h.FIELD_ENTREZ_ID, OldValue: "", NewValue: "7157"},
+				{Field: h.FIELD_NAME, OldValue: "tumor protein p53", NewValue: "renamed"},
+			},
+		},
+		{
+			"removed",
+			old,
+			h.NewRecordBuilder().WithHgncID("HGNC:11998").WithSymbol("TP53").Build(),
+			[]h.FieldChange{{Field: h.FIELD_NAME, OldValue: "tumor protein p53", NewValue: ""}},
+		},
+		{
+			"missing is the same as empty",
+			h.NewRecordBuilder().WithSymbol("TP53").With(h.FIELD_OMIM_ID, "").Build(),
+			h.NewRecordBuilder().WithSymbol("TP53").Build(),
+			nil,
+		},
+		{
+			"nil other",
+			h.NewRecordBuilder().WithSymbol("TP53").Build(),
+			nil,
+			[]h.FieldChange{{Field: h.FIELD_SYMBOL, OldValue: "TP53", NewValue: ""}},
+		},
+		{
+			"nil record",
+			nil,
+			h.NewRecordBuilder().WithSymbol("TP53").Build(),
+			[]h.FieldChange{{Field: h.FIELD_SYMBOL, OldValue: "", NewValue: "TP53"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.old.CompareFields(tt.new)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("CompareFields() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package hgnc_go
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// GenomicCoordinates is the position of a gene on the genome, as given by an Ensembl GTF file.
+// Start and End are 1-based and inclusive, Strand is '+' or '-'.
+type GenomicCoordinates struct {
+	Chromosome string
+	Start      int64
+	End        int64
+	Strand     byte
+}
+
+// LoadEnsemblGTF reads gene coordinates from an Ensembl GTF file (e.g. Homo_sapiens.GRCh38.113.gtf.gz)
+// into hgnc, replacing coordinates loaded before. Only "gene" feature lines are used, keyed by
+// gene_id without version suffix. See GetCoordinates.
+func LoadEnsemblGTF(hgnc *HGNC, gtfPath string, gzipped bool) error {
+
+	if hgnc == nil {
+		panic("HGNC is nil")
+	}
+
+	fh, err := os.Open(gtfPath)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+
+	var r io.Reader = fh
+	if gzipped {
+		gz, err := gzip.NewReader(fh)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	coordinates, err := parseEnsemblGTF(r)
+	if err != nil {
+		return fmt.Errorf("%s: %w", gtfPath, err)
+	}
+
+	hgnc.mu.Lock()
+	defer hgnc.mu.Unlock()
+	hgnc.coordinates = coordinates
+	return nil
+}
+
+// parseEnsemblGTF parses gene lines of GTF data, keyed by Ensembl gene ID.
+func parseEnsemblGTF(r io.Reader) (map[string]*GenomicCoordinates, error) {
+
+	coordinates := make(map[string]*GenomicCoordinates)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024) // attribute columns may be long
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		// seqname, source, feature, start, end, score, strand, frame, attributes
+		columns := strings.Split(line, "\t")
+		if len(columns) < 9 {
+			return nil, fmt.Errorf("line %d: expected 9 columns, got %d", lineNo, len(columns))
+		}
+		if columns[2] != "gene" {
+			continue
+		}
+
+		geneID := gtfAttribute(columns[8], "gene_id")
+		if geneID == "" {
+			return nil, fmt.Errorf("line %d: gene_id not found", lineNo)
+		}
+		start, err := strconv.ParseInt(columns[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid start: %w", lineNo, err)
+		}
+		end, err := strconv.ParseInt(columns[4], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid end: %w", lineNo, err)
+		}
+		if len(columns[6]) != 1 {
+			return nil, fmt.Errorf("line %d: invalid strand %q", lineNo, columns[6])
+		}
+
+		coordinates[stripVersion(geneID)] = &GenomicCoordinates{
+			Chromosome: columns[0],
+			Start:      start,
+			End:        end,
+			Strand:     columns[6][0],
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return coordinates, nil
+}
+
+// gtfAttribute gets the value of key from a GTF attribute column, e.g. `gene_id "ENSG00000141510"; ...`.
+func gtfAttribute(attributes, key string) string {
+	for _, attribute := range strings.Split(attributes, ";") {
+		name, value, found := strings.Cut(strings.TrimSpace(attribute), " ")
+		if found && name == key {
+			return strings.Trim(strings.TrimSpace(value), "\"")
+		}
+	}
+	return ""
+}
+
+// GetCoordinates gets genomic coordinates of a gene (hgnc id, ensembl id, entrez id, ucsc id or symbol),
+// through its Ensembl gene ID. Coordinates must be loaded by LoadEnsemblGTF first.
+func (h *HGNC) GetCoordinates(gene string) (*GenomicCoordinates, bool) {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	gene = strings.TrimSpace(gene)
+	var ensgs []string
+	if field := classifyGeneStringSystem(gene); field == FIELD_ENSEMBL_GENE_ID {
+		ensgs = []string{stripVersion(gene)}
+	} else {
+		ensgs = h.Lookup(gene, field, FIELD_ENSEMBL_GENE_ID)
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for _, ensg := range ensgs {
+		if coordinates, ok := h.coordinates[ensg]; ok {
+			return coordinates, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,103 @@
+package hgnc_go_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+)
+
+const testGTF = "#!genome-build GRCh38.p14\n" +
+	"17\tensembl_havana\tgene\t7661779\t7687538\t.\t-\t.\tgene_id \"ENSG00000141510.17\"; gene_name \"TP53\"; gene_biotype \"protein_coding\";\n" +
+	"17\tensembl_havana\ttranscript\t7661779\t7687538\t.\t-\t.\tgene_id \"ENSG00000141510\"; transcript_id \"ENST00000269305\";\n" +
+	"17\tensembl_havana\tgene\t43044295\t43170245\t.\t-\t.\tgene_id \"ENSG00000012048\"; gene_name \"BRCA1\";\n"
+
+// writeGTF writes data to a GTF file in a temporary directory, gzipped if asked.
+func writeGTF(t *testing.T, data string, gzipped bool) string {
+	t.Helper()
+	content := []byte(data)
+	if gzipped {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		gz.Write(content)
+		if err := gz.Close(); err != nil {
+			t.Fatal(err)
+		}
+		content = buf.Bytes()
+	}
+	path := filepath.Join(t.TempDir(), "genes.gtf")
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadEnsemblGTF(t *testing.T) {
+
+	tp53 := h.GenomicCoordinates{Chromosome: "17", Start: 7661779, End: 7687538, Strand: '-'}
+	brca1 := h.GenomicCoordinates{Chromosome: "17", Start: 43044295, End: 43170245, Strand: '-'}
+
+	for _, gzipped := range []bool{false, true} {
+		hgnc := newGeneHGNC()
+		if err := h.LoadEnsemblGTF(hgnc, writeGTF(t, testGTF, gzipped), gzipped); err != nil {
+			t.Fatalf("LoadEnsemblGTF(gzipped=%v): %v", gzipped, err)
+		}
+
+		tests := []struct {
+			gene      string
+			want      h.GenomicCoordinates
+			wantFound bool
+		}{
+			{"TP53", tp53, true},
+			{"p53", tp53, true},
+			{"HGNC:11998", tp53, true},
+			{"7157", tp53, true},
+			{"ENSG00000141510", tp53, true},
+			{"ENSG00000141510.17", tp53, true},
+			{"672", brca1, true},
+			{"MIR21", h.GenomicCoordinates{}, false},
+			{"NOPE", h.GenomicCoordinates{}, false},
+		}
+		for _, tt := range tests {
+			got, found := hgnc.GetCoordinates(tt.gene)
+			if found != tt.wantFound || (found && *got != tt.want) {
+				t.Errorf("GetCoordinates(%q) with gzipped=%v = (%+v, %v), want (%+v, %v)", tt.gene, gzipped, got, found, tt.want, tt.wantFound)
+			}
+		}
+	}
+}
+
+func TestLoadEnsemblGTFErrors(t *testing.T) {
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"too few columns", "17\tensembl\tgene\t1\t2\n"},
+		{"no gene_id", "17\tensembl\tgene\t1\t2\t.\t+\t.\tgene_name \"TP53\";\n"},
+		{"invalid start", "17\tensembl\tgene\tx\t2\t.\t+\t.\tgene_id \"ENSG00000141510\";\n"},
+		{"invalid end", "17\tensembl\tgene\t1\tx\t.\t+\t.\tgene_id \"ENSG00000141510\";\n"},
+		{"invalid strand", "17\tensembl\tgene\t1\t2\t.\t\t.\tgene_id \"ENSG00000141510\";\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := h.LoadEnsemblGTF(newGeneHGNC(), writeGTF(t, tt.data, false), false); err == nil {
+				t.Error("LoadEnsemblGTF succeeded, want an error")
+			}
+		})
+	}
+
+	t.Run("not gzipped", func(t *testing.T) {
+		if err := h.LoadEnsemblGTF(newGeneHGNC(), writeGTF(t, testGTF, false), true); err == nil {
+			t.Error("LoadEnsemblGTF succeeded, want an error")
+		}
+	})
+	t.Run("missing file", func(t *testing.T) {
+		if err := h.LoadEnsemblGTF(newGeneHGNC(), filepath.Join(t.TempDir(), "missing.gtf"), false); err == nil {
+			t.Error("LoadEnsemblGTF succeeded, want an error")
+		}
+	})
+}
@@ -0,0 +1,188 @@
+package hgnc_go
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// hgncDateLayout is the date format used in HGNC files, e.g. 2023-01-10.
+const hgncDateLayout = "2006-01-02"
+
+// ParseHGNCDate parses a date string of HGNC files (YYYY-MM-DD) in UTC.
+func ParseHGNCDate(dateStr string) (time.Time, error) {
+	t, err := time.Parse(hgncDateLayout, strings.TrimSpace(dateStr))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid HGNC date %q: %w", dateStr, err)
+	}
+	return t, nil
+}
+
+// FetchModifiedAfter retrieves records whose date_modified is after since.
+// Records without date_modified are skipped; an unparseable date results in an error.
+func (h *HGNC) FetchModifiedAfter(since time.Time) ([]*Record, error) {
+	return h.fetchByDate(FIELD_DATE_MODIFIED, func(t time.Time) bool {
+		return t.After(since)
+	})
+}
+
+// FetchApprovedBetween retrieves records whose date_approved_reserved is within [from, to].
+// It scans all records, O(n).
+func (h *HGNC) FetchApprovedBetween(from, to time.Time) ([]*Record, error) {
+	return h.fetchByDate(FIELD_DATE_APPROVED_RESERVED, func(t time.Time) bool {
+		return !t.Before(from) && !t.After(to)
+	})
+}
+
+// FetchModifiedBetween retrieves records whose date_modified is within [from, to].
+// It scans all records, O(n).
+func (h *HGNC) FetchModifiedBetween(from, to time.Time) ([]*Record, error) {
+	return h.fetchByDate(FIELD_DATE_MODIFIED, func(t time.Time) bool {
+		return !t.Before(from) && !t.After(to)
+	})
+}
+
+// fetchByDate retrieves records whose date field satisfies match.
+// Records with an empty date are skipped; an unparseable date results in an error.
+func (h *HGNC) fetchByDate(field Field, match func(time.Time) bool) ([]*Record, error) {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	results := make([]*Record, 0)
+	for _, record := range h.records {
+		dateStr := record.data[field]
+		if dateStr == "" {
+			continue
+		}
+		t, err := ParseHGNCDate(dateStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", record.data[FIELD_HGNC_ID], err)
+		}
+		if match(t) {
+			results = append(results, record)
+		}
+	}
+	return results, nil
+}
+
+// RecordDates holds all date fields of a Record, parsed.
+// Dates missing in the raw data are the zero time.Time.
+type RecordDates struct {
+	ApprovedReserved time.Time // FIELD_DATE_APPROVED_RESERVED
+	SymbolChanged    time.Time // FIELD_DATE_SYMBOL_CHANGED
+	NameChanged      time.Time // FIELD_DATE_NAME_CHANGED
+	Modified         time.Time // FIELD_DATE_MODIFIED
+	ZeroIfUnset      bool      // whether missing dates are zero instead of errors, always true for now
+}
+
+// Dates parses all date fields of the record.
+// It returns an error if any non-empty date field is unparseable.
+func (r *Record) Dates() (*RecordDates, error) {
+
+	dates := &RecordDates{ZeroIfUnset: true}
+
+	targets := []struct {
+		field Field
+		dst   *time.Time
+	}{
+		{FIELD_DATE_APPROVED_RESERVED, &dates.ApprovedReserved},
+		{FIELD_DATE_SYMBOL_CHANGED, &dates.SymbolChanged},
+		{FIELD_DATE_NAME_CHANGED, &dates.NameChanged},
+		{FIELD_DATE_MODIFIED, &dates.Modified},
+	}
+	for _, target := range targets {
+		dateStr := r.data[target.field]
+		if dateStr == "" {
+			continue
+		}
+		t, err := ParseHGNCDate(dateStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", target.field, err)
+		}
+		*target.dst = t
+	}
+
+	return dates, nil
+}
+
+// SinceApproval returns the time elapsed since date_approved_reserved of the record.
+// It returns an error if the date is empty or unparseable.
+func (r *Record) SinceApproval() (time.Duration, error) {
+	return r.since(FIELD_DATE_APPROVED_RESERVED)
+}
+
+// SinceModified returns the time elapsed since date_modified of the record.
+// It returns an error if the date is empty or unparseable.
+func (r *Record) SinceModified() (time.Duration, error) {
+	return r.since(FIELD_DATE_MODIFIED)
+}
+
+// since returns the time elapsed since the date of field.
+func (r *Record) since(field Field) (time.Duration, error) {
+	dateStr := r.data[field]
+	if dateStr == "" {
+		return 0, fmt.Errorf("%s is empty", field)
+	}
+	t, err := ParseHGNCDate(dateStr)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", field, err)
+	}
+	return time.Since(t), nil
+}
+
+// GetRecentlyModified returns the n most recently modified records (by date_modified), newest first.
+// Records without date_modified are skipped; an unparseable date results in an error.
+func (h *HGNC) GetRecentlyModified(n int) ([]*Record, error) {
+	return h.getRecent(FIELD_DATE_MODIFIED, n)
+}
+
+// GetRecentlyApproved returns the n most recently approved records (by date_approved_reserved), newest first.
+// Records without date_approved_reserved are skipped; an unparseable date results in an error.
+func (h *HGNC) GetRecentlyApproved(n int) ([]*Record, error) {
+	return h.getRecent(FIELD_DATE_APPROVED_RESERVED, n)
+}
+
+// getRecent returns the n records with the latest date of field, newest first.
+// Records of the same date keep their load order.
+func (h *HGNC) getRecent(field Field, n int) ([]*Record, error) {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	type dated struct {
+		record *Record
+		date   time.Time
+	}
+	all := make([]dated, 0, len(h.records))
+	for _, record := range h.records {
+		dateStr := record.data[field]
+		if dateStr == "" {
+			continue
+		}
+		t, err := ParseHGNCDate(dateStr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", record.data[FIELD_HGNC_ID], err)
+		}
+		all = append(all, dated{record, t})
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].date.After(all[j].date)
+	})
+
+	n = max(min(n, len(all)), 0)
+	results := make([]*Record, 0, n)
+	for _, d := range all[:n] {
+		results = append(results, d.record)
+	}
+	return results, nil
+}
@@ -0,0 +1,168 @@
+package hgnc_go_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+// datedGene returns a record with date_approved_reserved and date_modified.
+func datedGene(hgncID, symbol, approved, modified string) *h.Record {
+	return h.NewRecordBuilder().WithHgncID(hgncID).WithSymbol(symbol).
+		With(h.FIELD_DATE_APPROVED_RESERVED, approved).
+		With(h.FIELD_DATE_MODIFIED, modified).
+		Build()
+}
+
+// date returns midnight UTC of a YYYY-MM-DD date.
+func date(t *testing.T, s string) time.Time {
+	d, err := h.ParseHGNCDate(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestFetchModifiedAfter(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(
+		datedGene("HGNC:1", "OLD", "1990-05-01", "2015-03-10"),
+		datedGene("HGNC:2", "MID", "2001-01-01", "2020-06-30"),
+		datedGene("HGNC:3", "NEW", "2020-01-01", "2024-01-15"),
+		datedGene("HGNC:4", "UNDATED", "", ""),
+	)
+
+	tests := []struct {
+		name  string
+		since string
+		want  []string
+	}{
+		{"all dated", "2000-01-01", []string{"OLD", "MID", "NEW"}},
+		{"exclusive", "2020-06-30", []string{"NEW"}},
+		{"day before", "2020-06-29", []string{"MID", "NEW"}},
+		{"none", "2025-01-01", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := hgnc.FetchModifiedAfter(date(t, tt.since))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := symbols(records); !slices.Equal(got, tt.want) {
+				t.Errorf("FetchModifiedAfter(%s) = %q, want %q", tt.since, got, tt.want)
+			}
+		})
+	}
+
+	hgnc.AddRecord(datedGene("HGNC:5", "BAD", "", "10/01/2023"))
+	if _, err := hgnc.FetchModifiedAfter(date(t, "2000-01-01")); err == nil {
+		t.Error("FetchModifiedAfter with an unparseable date_modified returned no error")
+	}
+}
+
+func TestParseHGNCDate(t *testing.T) {
+
+	tests := []struct {
+		s       string
+		want    time.Time
+		wantErr bool
+	}{
+		{"2023-01-10", time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC), false},
+		{" 2023-01-10\t", time.Date(2023, 1, 10, 0, 0, 0, 0, time.UTC), false},
+		{"2023-1-10", time.Time{}, true},
+		{"", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := h.ParseHGNCDate(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseHGNCDate(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseHGNCDate(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSince(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		record  *h.Record
+		since   func(*h.Record) (time.Duration, error)
+		date    string
+		wantErr bool
+	}{
+		{"approval", testutil.FixtureBRCA1(), (*h.Record).SinceApproval, "1991-01-01", false},
+		{"modification", testutil.FixtureTP53(), (*h.Record).SinceModified, "2023-01-10", false},
+		{"future date", datedGene("HGNC:1", "FUTURE", "", "2999-01-01"), (*h.Record).SinceModified, "2999-01-01", false},
+		{"empty date", datedGene("HGNC:1", "UNDATED", "", ""), (*h.Record).SinceApproval, "", true},
+		{"unparseable date", datedGene("HGNC:1", "BADDATE", "", "10/01/2023"), (*h.Record).SinceModified, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lower time.Duration
+			if !tt.wantErr {
+				lower = time.Since(date(t, tt.date))
+			}
+			got, err := tt.since(tt.record)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error: %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			upper := time.Since(date(t, tt.date))
+			if got < lower || got > upper {
+				t.Errorf("got %v, want between %v and %v", got, lower, upper)
+			}
+		})
+	}
+}
+
+func TestGetRecent(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(
+		datedGene("HGNC:1", "OLD", "1990-01-01", "2000-01-01"),
+		datedGene("HGNC:2", "NEW", "2020-01-01", "2024-01-01"),
+		datedGene("HGNC:3", "UNDATED", "", ""),
+		datedGene("HGNC:4", "SAMEDAY", "2020-01-01", "2024-01-01"),
+		datedGene("HGNC:5", "MIDDLE", "2010-01-01", "1999-01-01"),
+	)
+
+	tests := []struct {
+		name   string
+		recent func(int) ([]*h.Record, error)
+		n      int
+		want   []string
+	}{
+		{"modified", hgnc.GetRecentlyModified, 2, []string{"NEW", "SAMEDAY"}},
+		{"all modified", hgnc.GetRecentlyModified, 10, []string{"NEW", "SAMEDAY", "OLD", "MIDDLE"}},
+		{"no modified", hgnc.GetRecentlyModified, 0, []string{}},
+		{"negative n", hgnc.GetRecentlyModified, -1, []string{}},
+		{"approved", hgnc.GetRecentlyApproved, 3, []string{"NEW", "SAMEDAY", "MIDDLE"}},
+		{"all approved", hgnc.GetRecentlyApproved, 10, []string{"NEW", "SAMEDAY", "MIDDLE", "OLD"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.recent(tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(symbols(got), tt.want) {
+				t.Errorf("got %q, want %q", symbols(got), tt.want)
+			}
+		})
+	}
+
+	t.Run("unparseable date", func(t *testing.T) {
+		bad := testutil.NewMockHGNC(datedGene("HGNC:1", "OLD", "1990-01-01", "2000-01-01"), datedGene("HGNC:2", "BAD", "", "2000/01/01"))
+		if _, err := bad.GetRecentlyModified(1); err == nil {
+			t.Error("GetRecentlyModified succeeded, want an error")
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package hgnc_go
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// EnrichGeneList gets targetFields for each symbol (approved, alias or previous).
+// The first result is parallel to symbols, nil for symbols not found, the second
+// lists symbols not found in input order.
+func (h *HGNC) EnrichGeneList(symbols []string, targetFields []Field) ([]map[Field]string, []string) {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	trimmed := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		trimmed[i] = strings.TrimSpace(symbol)
+	}
+	found := h.BatchFetch(trimmed, FIELD_SYMBOL)
+
+	annotations := make([]map[Field]string, len(symbols))
+	unfound := make([]string, 0)
+	for i, symbol := range trimmed {
+		records := found[symbol]
+		if len(records) == 0 {
+			unfound = append(unfound, symbols[i])
+			continue
+		}
+		annotation := make(map[Field]string, len(targetFields))
+		for _, field := range targetFields {
+			annotation[field] = records[0].data[field]
+		}
+		annotations[i] = annotation
+	}
+	return annotations, unfound
+}
+
+// EnrichCSV reads CSV with a header line from r, appends a column for each of targetFields
+// with values of the gene in symbolColumn, and writes the result to w.
+// Cells of genes not found are left empty.
+func (h *HGNC) EnrichCSV(r io.Reader, symbolColumn string, targetFields []Field, w io.Writer) error {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("empty CSV input, header line expected")
+	}
+
+	// header
+	header := rows[0]
+	symbolIdx := -1
+	for i, column := range header {
+		if strings.TrimSpace(column) == symbolColumn {
+			symbolIdx = i
+			break
+		}
+	}
+	if symbolIdx < 0 {
+		return fmt.Errorf("column %q not found in CSV header", symbolColumn)
+	}
+
+	// annotate
+	symbols := make([]string, len(rows)-1)
+	for i, row := range rows[1:] {
+		if symbolIdx < len(row) {
+			symbols[i] = row[symbolIdx]
+		}
+	}
+	annotations, _ := h.EnrichGeneList(symbols, targetFields)
+
+	// write
+	cw := csv.NewWriter(w)
+	outHeader := append([]string{}, header...)
+	for _, field := range targetFields {
+		outHeader = append(outHeader, string(field))
+	}
+	if err := cw.Write(outHeader); err != nil {
+		return err
+	}
+	for i, row := range rows[1:] {
+		out := append([]string{}, row...)
+		for _, field := range targetFields {
+			out = append(out, annotations[i][field])
+		}
+		if err := cw.Write(out); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
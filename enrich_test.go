@@ -0,0 +1,92 @@
+package hgnc_go_test
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestEnrichGeneList(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1())
+	targets := []h.Field{h.FIELD_ENTREZ_ID, h.FIELD_LOCATION}
+	tp53 := map[h.Field]string{h.FIELD_ENTREZ_ID: "7157", h.FIELD_LOCATION: "17p13.1"}
+	brca1 := map[h.Field]string{h.FIELD_ENTREZ_ID: "672", h.FIELD_LOCATION: "17q21.31"}
+
+	tests := []struct {
+		name        string
+		symbols     []string
+		want        []map[h.Field]string
+		wantUnfound []string
+	}{
+		{"approved symbols", []string{"TP53", "BRCA1"}, []map[h.Field]string{tp53, brca1}, []string{}},
+		{"alias and spaces", []string{" p53 ", "RNF53"}, []map[h.Field]string{tp53, brca1}, []string{}},
+		{"not found", []string{"TP53", "NOPE", " ", "BRCA1"}, []map[h.Field]string{tp53, nil, nil, brca1}, []string{"NOPE", " "}},
+		{"duplicates", []string{"TP53", "TP53"}, []map[h.Field]string{tp53, tp53}, []string{}},
+		{"empty", nil, []map[h.Field]string{}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unfound := hgnc.EnrichGeneList(tt.symbols, targets)
+			if len(got) != len(tt.want) {
+				t.Fatalf("EnrichGeneList returned %d annotations, want %d", len(got), len(tt.want))
+			}
+			for i := range tt.want {
+				if (got[i] == nil) != (tt.want[i] == nil) || !maps.Equal(got[i], tt.want[i]) {
+					t.Errorf("annotation of %q = %v, want %v", tt.symbols[i], got[i], tt.want[i])
+				}
+			}
+			if unfound == nil || !slices.Equal(unfound, tt.wantUnfound) {
+				t.Errorf("unfound = %q, want %q", unfound, tt.wantUnfound)
+			}
+		})
+	}
+}
+
+func TestEnrichCSV(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1())
+	targets := []h.Field{h.FIELD_HGNC_ID, h.FIELD_ENTREZ_ID}
+
+	tests := []struct {
+		name    string
+		in      string
+		column  string
+		want    string
+		wantErr bool
+	}{
+		{
+			"annotated",
+			"sample,gene\ns1,TP53\ns2,p53\ns3,NOPE\n",
+			"gene",
+			"sample,gene,hgnc_id,entrez_id\ns1,TP53,HGNC:11998,7157\ns2,p53,HGNC:11998,7157\ns3,NOPE,,\n",
+			false,
+		},
+		{
+			"short rows and quoting",
+			"gene,note\nBRCA1,\"a, b\"\n\n",
+			"gene",
+			"gene,note,hgnc_id,entrez_id\nBRCA1,\"a, b\",HGNC:1100,672\n",
+			false,
+		},
+		{"header only", "gene\n", "gene", "gene,hgnc_id,entrez_id\n", false},
+		{"missing column", "sample,symbol\ns1,TP53\n", "gene", "", true},
+		{"empty input", "", "gene", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			err := hgnc.EnrichCSV(strings.NewReader(tt.in), tt.column, targets, &out)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EnrichCSV() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := out.String(); got != tt.want {
+				t.Errorf("EnrichCSV() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package hgnc_go
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ExportToJSON writes records to w as a JSON array of objects.
+// Keys of each object are field names (e.g. "hgnc_id", "symbol").
+// Records are encoded one by one, the whole output is never held in memory.
+func (h *HGNC) ExportToJSON(w io.Writer, records []*Record) error {
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	for i, record := range records {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if err := encoder.Encode(record.data); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportAllToJSON writes all records of HGNC to w as a JSON array.
+func (h *HGNC) ExportAllToJSON(w io.Writer) error {
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.ExportToJSON(w, h.records)
+}
+
+// ExportFilteredToJSON writes records for which predicate returns true to w as a JSON array.
+// Filtering and encoding are done in one pass.
+func (h *HGNC) ExportFilteredToJSON(w io.Writer, predicate func(*Record) bool) error {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(w)
+	first := true
+	for _, record := range h.records {
+		if !predicate(record) {
+			continue
+		}
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if err := encoder.Encode(record.data); err != nil {
+			return err
+		}
+		first = false
+	}
+
+	_, err := io.WriteString(w, "]\n")
+	return err
+}
+
+// ExportToTSV writes records to w in TSV format, with a header line of the given field names.
+// Multi-value fields are written pipe-delimited, exactly as in the source file.
+func (h *HGNC) ExportToTSV(w io.Writer, records []*Record, fields []Field) error {
+
+	bw := bufio.NewWriter(w)
+
+	// header line
+	header := make([]string, len(fields))
+	for i, field := range fields {
+		header[i] = string(field)
+	}
+	if _, err := bw.WriteString(strings.Join(header, "\t") + "\n"); err != nil {
+		return err
+	}
+
+	// records
+	values := make([]string, len(fields))
+	for _, record := range records {
+		for i, field := range fields {
+			values[i] = record.data[field]
+		}
+		if _, err := bw.WriteString(strings.Join(values, "\t") + "\n"); err != nil {
+			return err
+		}
+	}
+
+	return bw.Flush()
+}
+
+// ExportAllToTSV writes all records of HGNC to w in TSV format.
+func (h *HGNC) ExportAllToTSV(w io.Writer, fields []Field) error {
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.ExportToTSV(w, h.records, fields)
+}
+
+// Column holds the values of one field for a list of records, in record order.
+// It is the columnar layout used by analytics formats such as Apache Arrow and Parquet,
+// see the arrow module for exporting to them.
+type Column struct {
+	Field  Field
+	Values []string   // raw values, as in the source file
+	Lists  [][]string // split values of multi-value fields, nil for single-value fields
+}
+
+// ExportColumns converts records to one Column per requested field.
+// Multi-value fields get Lists populated, the equivalent of an Arrow List<Utf8> column.
+func ExportColumns(records []*Record, fields []Field) []Column {
+
+	columns := make([]Column, len(fields))
+	for i, field := range fields {
+		column := Column{
+			Field:  field,
+			Values: make([]string, len(records)),
+		}
+		if IsMultiValueField(field) {
+			column.Lists = make([][]string, len(records))
+		}
+		for j, record := range records {
+			column.Values[j] = record.data[field]
+			if column.Lists != nil {
+				column.Lists[j] = SplitMultiValue(record.data[field])
+			}
+		}
+		columns[i] = column
+	}
+	return columns
+}
+
+// markdownMaxWidth is the max width of a Markdown table cell, longer values are truncated.
+const markdownMaxWidth = 40
+
+// ExportToMarkdownTable writes records to w as a GFM (GitHub Flavored Markdown) table,
+// with field names as headers. Values longer than 40 characters are truncated with "...".
+// Columns are padded to the width of their header or of their widest value, whichever
+// is smaller, cells wider than that overflow the column.
+func ExportToMarkdownTable(w io.Writer, records []*Record, fields []Field) error {
+
+	// cells
+	header := make([]string, len(fields))
+	for i, field := range fields {
+		header[i] = markdownCell(string(field))
+	}
+	valueWidths := make([]int, len(fields))
+	rows := make([][]string, len(records))
+	for j, record := range records {
+		rows[j] = make([]string, len(fields))
+		for i, field := range fields {
+			cell := markdownCell(record.data[field])
+			rows[j][i] = cell
+			valueWidths[i] = max(valueWidths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	widths := make([]int, len(fields))
+	for i := range fields {
+		widths[i] = max(min(utf8.RuneCountInString(header[i]), valueWidths[i]), 3) // "---"
+	}
+
+	bw := bufio.NewWriter(w)
+	writeRow := func(cells []string) {
+		bw.WriteString("|")
+		for i, cell := range cells {
+			bw.WriteString(" " + cell + strings.Repeat(" ", max(widths[i]-utf8.RuneCountInString(cell), 0)) + " |")
+		}
+		bw.WriteString("\n")
+	}
+
+	writeRow(header)
+	separator := make([]string, len(fields))
+	for i := range fields {
+		separator[i] = strings.Repeat("-", widths[i])
+	}
+	writeRow(separator)
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	return bw.Flush()
+}
+
+// markdownCell truncates a value and escapes "|" so it fits in a table cell.
+func markdownCell(value string) string {
+	if utf8.RuneCountInString(value) > markdownMaxWidth {
+		value = string([]rune(value)[:markdownMaxWidth-3]) + "..."
+	}
+	return strings.ReplaceAll(value, "|", "\\|")
+}
+
+// GOAnnotation is a Gene Ontology term annotated to a gene, see ExportToGAF.
+type GOAnnotation struct {
+	GoID      string // e.g. "GO:0003677"
+	Evidence  string // evidence code, e.g. "IDA"
+	Aspect    string // "F" (molecular function), "P" (biological process) or "C" (cellular component)
+	Reference string // DB:Reference, e.g. "PMID:2130941", gafDefaultReference if empty
+}
+
+// gafDefaultReference is the DB:Reference of annotations without one, the column is required.
+const gafDefaultReference = "GO_REF:0000000"
+
+// gafQualifiers are the default GAF 2.2 relations of each GO aspect.
+var gafQualifiers = map[string]string{
+	"F": "enables",
+	"P": "involved_in",
+	"C": "located_in",
+}
+
+// ExportToGAF writes GO annotations of records to w in GAF 2.2 (Gene Association Format),
+// one line per record and GO term. goAnnotations is keyed by HGNC ID or symbol, records
+// without annotations are skipped. Object columns come from the records (HGNC ID, symbol,
+// name, alias symbols as synonyms), the taxon is taxon:9606 and the date is today.
+// The DB:Reference column is "GO_REF:0000000" for annotations without Reference.
+// It returns an error for an Aspect other than F, P or C.
+func ExportToGAF(w io.Writer, records []*Record, goAnnotations map[string][]GOAnnotation) error {
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString("!gaf-version: 2.2\n")
+
+	date := time.Now().UTC().Format("20060102")
+	for _, record := range records {
+		annotations, ok := goAnnotations[record.data[FIELD_HGNC_ID]]
+		if !ok {
+			annotations = goAnnotations[record.data[FIELD_SYMBOL]]
+		}
+
+		objectType := "gene_product"
+		if isCodingLocusGroup(record.data[FIELD_LOCUS_GROUP]) {
+			objectType = "protein"
+		}
+
+		for _, annotation := range annotations {
+			qualifier, ok := gafQualifiers[annotation.Aspect]
+			if !ok {
+				return fmt.Errorf("unknown GO aspect %q of %s for %s, expected F, P or C",
+					annotation.Aspect, annotation.GoID, record.data[FIELD_SYMBOL])
+			}
+			reference := annotation.Reference
+			if reference == "" {
+				reference = gafDefaultReference
+			}
+			columns := []string{
+				"HGNC",                          // DB
+				record.data[FIELD_HGNC_ID],      // DB_Object_ID
+				record.data[FIELD_SYMBOL],       // DB_Object_Symbol
+				qualifier,                       // Qualifier
+				annotation.GoID,                 // GO_ID
+				reference,                       // DB:Reference
+				annotation.Evidence,             // Evidence_Code
+				"",                              // With_or_From
+				annotation.Aspect,               // Aspect
+				record.data[FIELD_NAME],         // DB_Object_Name
+				record.data[FIELD_ALIAS_SYMBOL], // DB_Object_Synonym, pipe-delimited
+				objectType,                      // DB_Object_Type
+				"taxon:9606",                    // Taxon
+				date,                            // Date
+				"HGNC",                          // Assigned_By
+				"",                              // Annotation_Extension
+				"",                              // Gene_Product_Form_ID
+			}
+			if _, err := bw.WriteString(strings.Join(columns, "\t") + "\n"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return bw.Flush()
+}
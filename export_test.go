@@ -0,0 +1,186 @@
+package hgnc_go_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestExportToGAF(t *testing.T) {
+
+	records := []*h.Record{testutil.FixtureTP53(), testutil.FixtureMIR21()}
+
+	tests := []struct {
+		name        string
+		annotations map[string][]h.GOAnnotation
+		wantLines   [][]string // columns 1-9 of each line
+		wantErr     string
+	}{
+		{
+			"by hgnc id and symbol",
+			map[string][]h.GOAnnotation{
+				"HGNC:11998": {
+					{GoID: "GO:0003677", Evidence: "IDA", Aspect: "F", Reference: "PMID:2130941"},
+					{GoID: "GO:0006915", Evidence: "IMP", Aspect: "P"},
+				},
+				"MIR21": {{GoID: "GO:0005615", Evidence: "IDA", Aspect: "C"}},
+			},
+			[][]string{
+				{"HGNC", "HGNC:11998", "TP53", "enables", "GO:0003677", "PMID:2130941", "IDA", "", "F"},
+				{"HGNC", "HGNC:11998", "TP53", "involved_in", "GO:0006915", "GO_REF:0000000", "IMP", "", "P"},
+				{"HGNC", "HGNC:31586", "MIR21", "located_in", "GO:0005615", "GO_REF:0000000", "IDA", "", "C"},
+			},
+			"",
+		},
+		{
+			"records without annotations",
+			map[string][]h.GOAnnotation{"BRCA1": {{GoID: "GO:0003677", Evidence: "IDA", Aspect: "F"}}},
+			nil,
+			"",
+		},
+		{
+			"unknown aspect",
+			map[string][]h.GOAnnotation{"TP53": {{GoID: "GO:0003677", Evidence: "IDA", Aspect: "X"}}},
+			nil,
+			`unknown GO aspect "X"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := h.ExportToGAF(&buf, records, tt.annotations)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ExportToGAF() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			if lines[0] != "!gaf-version: 2.2" {
+				t.Errorf("header = %q, want !gaf-version: 2.2", lines[0])
+			}
+			lines = lines[1:]
+			if len(lines) != len(tt.wantLines) {
+				t.Fatalf("got %d lines, want %d:\n%s", len(lines), len(tt.wantLines), buf.String())
+			}
+			for i, line := range lines {
+				columns := strings.Split(line, "\t")
+				if len(columns) != 17 {
+					t.Fatalf("line %d has %d columns, want 17", i, len(columns))
+				}
+				for j, want := range tt.wantLines[i] {
+					if columns[j] != want {
+						t.Errorf("line %d column %d = %q, want %q", i, j+1, columns[j], want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestExportToMarkdownTable(t *testing.T) {
+
+	tp53 := testutil.FixtureTP53()
+	long := h.NewRecordBuilder().WithSymbol("LONG").WithName(strings.Repeat("x", 50)).Build()
+
+	tests := []struct {
+		name    string
+		records []*h.Record
+		fields  []h.Field
+		want    string
+	}{
+		{
+			"header narrower than values",
+			[]*h.Record{tp53},
+			[]h.Field{h.FIELD_NAME},
+			"| name |\n| ---- |\n| tumor protein p53 |\n",
+		},
+		{
+			"values narrower than header",
+			[]*h.Record{tp53},
+			[]h.Field{h.FIELD_SYMBOL, h.FIELD_ENTREZ_ID},
+			"| symbol | entrez_id |\n| ---- | ---- |\n| TP53 | 7157 |\n",
+		},
+		{
+			"short values pad to the separator",
+			[]*h.Record{h.NewRecordBuilder().WithSymbol("A").Build()},
+			[]h.Field{h.FIELD_SYMBOL},
+			"| symbol |\n| --- |\n| A   |\n",
+		},
+		{
+			"long values truncated and pipes escaped",
+			[]*h.Record{long, tp53},
+			[]h.Field{h.FIELD_NAME, h.FIELD_ALIAS_SYMBOL},
+			"| name | alias_symbol |\n| ---- | --------- |\n| " + strings.Repeat("x", 37) + "... |           |\n| tumor protein p53 | p53\\|LFS1 |\n",
+		},
+		{
+			"no records",
+			nil,
+			[]h.Field{h.FIELD_SYMBOL},
+			"| symbol |\n| --- |\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := h.ExportToMarkdownTable(&buf, tt.records, tt.fields); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("ExportToMarkdownTable() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExportToJSON(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1(), testutil.FixtureMIR21())
+
+	tests := []struct {
+		name   string
+		export func(w io.Writer) error
+		want   []string // symbols
+	}{
+		{"records", func(w io.Writer) error {
+			return hgnc.ExportToJSON(w, hgnc.Fetch("BRCA1", h.FIELD_SYMBOL))
+		}, []string{"BRCA1"}},
+		{"no records", func(w io.Writer) error { return hgnc.ExportToJSON(w, nil) }, []string{}},
+		{"all", hgnc.ExportAllToJSON, []string{"TP53", "BRCA1", "MIR21"}},
+		{"filtered", func(w io.Writer) error {
+			return hgnc.ExportFilteredToJSON(w, func(r *h.Record) bool { return r.LocusGroupEnum() == h.LocusGroupProteinCoding })
+		}, []string{"TP53", "BRCA1"}},
+		{"filtered, no match", func(w io.Writer) error {
+			return hgnc.ExportFilteredToJSON(w, func(*h.Record) bool { return false })
+		}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.export(&buf); err != nil {
+				t.Fatal(err)
+			}
+			var objects []map[string]string
+			if err := json.Unmarshal(buf.Bytes(), &objects); err != nil {
+				t.Fatalf("invalid JSON %q: %v", buf.String(), err)
+			}
+			got := make([]string, len(objects))
+			for i, object := range objects {
+				got[i] = object[string(h.FIELD_SYMBOL)]
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("exported symbols %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
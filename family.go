@@ -0,0 +1,91 @@
+package hgnc_go
+
+import (
+	"sort"
+	"strings"
+)
+
+// GeneFamilyEntry is a gene family (or group) as assigned by the HGNC.
+type GeneFamilyEntry struct {
+	Name string
+	ID   string
+}
+
+// AllGeneFamilies returns all gene families in HGNC, deduplicated and sorted by name then ID.
+// gene_family and gene_family_id are pipe-delimited and parallel:
+// the Nth name corresponds to the Nth ID.
+func (h *HGNC) AllGeneFamilies() []GeneFamilyEntry {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	seen := make(map[GeneFamilyEntry]struct{})
+	for _, record := range h.records {
+		for _, family := range recordGeneFamilies(record) {
+			seen[family] = struct{}{}
+		}
+	}
+
+	results := make([]GeneFamilyEntry, 0, len(seen))
+	for family := range seen {
+		results = append(results, family)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Name != results[j].Name {
+			return results[i].Name < results[j].Name
+		}
+		return results[i].ID < results[j].ID
+	})
+	return results
+}
+
+// FetchGenesByFamily retrieves records belonging to the named gene family.
+func (h *HGNC) FetchGenesByFamily(familyName string) []*Record {
+
+	familyName = strings.TrimSpace(familyName)
+	if familyName == "" {
+		return make([]*Record, 0)
+	}
+
+	return h.Filter(func(r *Record) bool {
+		for _, name := range SplitMultiValue(r.data[FIELD_GENE_FAMILY]) {
+			if name == familyName {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// recordGeneFamilies pairs gene_family and gene_family_id tokens of a record.
+func recordGeneFamilies(record *Record) []GeneFamilyEntry {
+
+	namesStr := record.data[FIELD_GENE_FAMILY]
+	idsStr := record.data[FIELD_GENE_FAMILY_ID]
+	if namesStr == "" && idsStr == "" {
+		return nil
+	}
+
+	names := strings.Split(namesStr, "|")
+	ids := strings.Split(idsStr, "|")
+
+	families := make([]GeneFamilyEntry, 0, max(len(names), len(ids)))
+	for i := 0; i < max(len(names), len(ids)); i++ {
+		var family GeneFamilyEntry
+		if i < len(names) {
+			family.Name = strings.TrimSpace(names[i])
+		}
+		if i < len(ids) {
+			family.ID = strings.TrimSpace(ids[i])
+		}
+		if family.Name == "" && family.ID == "" {
+			continue
+		}
+		families = append(families, family)
+	}
+	return families
+}
@@ -0,0 +1,54 @@
+package hgnc_go
+
+// fhirSystems are code systems of fields cross-referenced by ToFHIRGene, in output order.
+var fhirSystems = []struct {
+	field  Field
+	system string
+}{
+	{FIELD_HGNC_ID, "http://www.genenames.org/geneId"},
+	{FIELD_ENTREZ_ID, "http://www.ncbi.nlm.nih.gov/gene"},
+	{FIELD_ENSEMBL_GENE_ID, "http://www.ensembl.org"},
+	{FIELD_REFSEQ_ACCESSION, "http://www.ncbi.nlm.nih.gov/refseq"},
+	{FIELD_OMIM_ID, "http://www.omim.org"},
+	{FIELD_UNIPROT_IDS, "http://www.uniprot.org"},
+}
+
+// ToFHIRGene converts the Record to a map shaped like a FHIR Genomics Gene resource, ready for
+// encoding/json: "geneId" (HGNC ID), "symbol", "name", "chromosome", and "identifier", the
+// cross-references (HGNC, NCBI Gene, Ensembl, RefSeq, OMIM, UniProt) as CodeableConcepts.
+// Empty values are omitted. It is a convenience bridge, not a validated FHIR resource.
+func (r *Record) ToFHIRGene() map[string]interface{} {
+
+	if r == nil {
+		return nil
+	}
+
+	gene := map[string]interface{}{
+		"resourceType": "Gene",
+		"geneId":       r.data[FIELD_HGNC_ID],
+		"symbol":       r.data[FIELD_SYMBOL],
+	}
+	if name := r.data[FIELD_NAME]; name != "" {
+		gene["name"] = name
+	}
+	if chromosome := r.GetChromosome(); chromosome != "" {
+		gene["chromosome"] = chromosome
+	}
+
+	identifiers := make([]interface{}, 0)
+	for _, s := range fhirSystems {
+		for _, code := range SplitMultiValue(r.data[s.field]) {
+			identifiers = append(identifiers, map[string]interface{}{
+				"coding": []interface{}{
+					map[string]interface{}{"system": s.system, "code": code},
+				},
+				"text": code,
+			})
+		}
+	}
+	if len(identifiers) > 0 {
+		gene["identifier"] = identifiers
+	}
+
+	return gene
+}
@@ -0,0 +1,90 @@
+package hgnc_go_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+// fhirGene is the part of a FHIR Gene resource checked by TestToFHIRGene.
+type fhirGene struct {
+	ResourceType string `json:"resourceType"`
+	GeneID       string `json:"geneId"`
+	Symbol       string `json:"symbol"`
+	Name         string `json:"name"`
+	Chromosome   string `json:"chromosome"`
+	Identifier   []struct {
+		Coding []struct {
+			System string `json:"system"`
+			Code   string `json:"code"`
+		} `json:"coding"`
+		Text string `json:"text"`
+	} `json:"identifier"`
+}
+
+func TestToFHIRGene(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		record         *h.Record
+		wantGeneID     string
+		wantSymbol     string
+		wantName       string
+		wantChromosome string
+		wantCodes      []string
+	}{
+		{
+			"TP53", testutil.FixtureTP53(), "HGNC:11998", "TP53", "tumor protein p53", "17",
+			[]string{"HGNC:11998", "7157", "ENSG00000141510", "NM_000546", "191170", "P04637"},
+		},
+		{
+			"without name and location",
+			h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("A1BG").WithOmimID("138670").Build(),
+			"HGNC:1", "A1BG", "", "", []string{"HGNC:1", "138670"},
+		},
+		{"empty", h.NewRecordBuilder().Build(), "", "", "", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.record.ToFHIRGene())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !json.Valid(data) {
+				t.Fatalf("ToFHIRGene() is not valid JSON: %s", data)
+			}
+			var gene fhirGene
+			if err := json.Unmarshal(data, &gene); err != nil {
+				t.Fatal(err)
+			}
+
+			if gene.ResourceType != "Gene" {
+				t.Errorf("resourceType = %q, want Gene", gene.ResourceType)
+			}
+			if gene.GeneID != tt.wantGeneID || gene.Symbol != tt.wantSymbol {
+				t.Errorf("geneId, symbol = %q, %q, want %q, %q", gene.GeneID, gene.Symbol, tt.wantGeneID, tt.wantSymbol)
+			}
+			if gene.Name != tt.wantName || gene.Chromosome != tt.wantChromosome {
+				t.Errorf("name, chromosome = %q, %q, want %q, %q", gene.Name, gene.Chromosome, tt.wantName, tt.wantChromosome)
+			}
+			var codes []string
+			for _, identifier := range gene.Identifier {
+				if len(identifier.Coding) != 1 || identifier.Coding[0].System == "" || identifier.Coding[0].Code != identifier.Text {
+					t.Errorf("malformed identifier %+v", identifier)
+					continue
+				}
+				codes = append(codes, identifier.Coding[0].Code)
+			}
+			if !slices.Equal(codes, tt.wantCodes) {
+				t.Errorf("identifier codes = %q, want %q", codes, tt.wantCodes)
+			}
+		})
+	}
+
+	if gene := (*h.Record)(nil).ToFHIRGene(); gene != nil {
+		t.Errorf("ToFHIRGene() of a nil record = %v, want nil", gene)
+	}
+}
@@ -1,5 +1,7 @@
 package hgnc_go
 
+import "strings"
+
 type Field string
 
 const (
@@ -13,6 +15,8 @@ const (
 	FIELD_UCSC_ID          Field = "ucsc_id"          // #22
 	FIELD_REFSEQ_ACCESSION Field = "refseq_accession" // #24
 	FIELD_OMIM_ID          Field = "omim_id"          // #32
+	FIELD_ENA              Field = "ena"              // #23
+	FIELD_UNIPROT_IDS      Field = "uniprot_ids"      // #26
 
 	// ---------------- others
 
@@ -33,9 +37,7 @@ const (
 	FIELD_DATE_NAME_CHANGED        Field = "date_name_changed"        // #17
 	FIELD_DATE_MODIFIED            Field = "date_modified"            // #18
 	FIELD_VEGA_ID                  Field = "vega_id"                  // #21
-	FIELD_ENA                      Field = "ena"                      // #23
 	FIELD_CCDS_ID                  Field = "ccds_id"                  // #25
-	FIELD_UNIPROT_IDS              Field = "uniprot_ids"              // #26
 	FIELD_PUBMED_ID                Field = "pubmed_id"                // #27
 	FIELD_MGD_ID                   Field = "mgd_id"                   // #28
 	FIELD_RGD_ID                   Field = "rgd_id"                   // #29
@@ -67,10 +69,149 @@ var indexedFields = []Field{
 	FIELD_ENTREZ_ID,
 	FIELD_ENSEMBL_GENE_ID,
 	FIELD_UCSC_ID,
+	FIELD_REFSEQ_ACCESSION, // per accession
+	FIELD_OMIM_ID,          // per id
+	FIELD_ENA,              // per accession
+	FIELD_UNIPROT_IDS,      // per accession
+}
+
+// allFields lists every Field constant, in the order of columns in the HGNC file.
+var allFields = []Field{
+	FIELD_HGNC_ID,
+	FIELD_SYMBOL,
+	FIELD_NAME,
+	FIELD_LOCUS_GROUP,
+	FIELD_LOCUS_TYPE,
+	FIELD_STATUS,
+	FIELD_LOCATION,
+	FIELD_LOCATION_SORTABLE,
+	FIELD_ALIAS_SYMBOL,
+	FIELD_ALIAS_NAME,
+	FIELD_PREV_SYMBOL,
+	FIELD_PREV_NAME,
+	FIELD_GENE_FAMILY,
+	FIELD_GENE_FAMILY_ID,
+	FIELD_DATE_APPROVED_RESERVED,
+	FIELD_DATE_SYMBOL_CHANGED,
+	FIELD_DATE_NAME_CHANGED,
+	FIELD_DATE_MODIFIED,
+	FIELD_ENTREZ_ID,
+	FIELD_ENSEMBL_GENE_ID,
+	FIELD_VEGA_ID,
+	FIELD_UCSC_ID,
+	FIELD_ENA,
 	FIELD_REFSEQ_ACCESSION,
+	FIELD_CCDS_ID,
+	FIELD_UNIPROT_IDS,
+	FIELD_PUBMED_ID,
+	FIELD_MGD_ID,
+	FIELD_RGD_ID,
+	FIELD_LSDB,
+	FIELD_COSMIC,
 	FIELD_OMIM_ID,
+	FIELD_MIRBASE,
+	FIELD_HOMEODB,
+	FIELD_SNORNABASE,
+	FIELD_BIOPARADIGMS_SLC,
+	FIELD_ORPHANET,
+	FIELD_PSEUDOGENE_ORG,
+	FIELD_HORDE_ID,
+	FIELD_MEROPS,
+	FIELD_IMGT,
+	FIELD_IUPHAR,
+	FIELD_KZNF_GENE_CATALOG,
+	FIELD_MAMIT_TRNADB,
+	FIELD_CD,
+	FIELD_LNCRNADB,
+	FIELD_ENZYME_ID,
+	FIELD_INTERMEDIATE_FILAMENT_DB,
+	FIELD_AGR,
+	FIELD_MANE_SELECT,
+}
+
+// AllFields returns every known field, in the order of columns in the HGNC file.
+func AllFields() []Field {
+	result := make([]Field, len(allFields))
+	copy(result, allFields)
+	return result
+}
+
+// AllFieldNames returns names of every known field, in the order of columns in the HGNC file.
+func AllFieldNames() []string {
+	result := make([]string, len(allFields))
+	for i, f := range allFields {
+		result[i] = string(f)
+	}
+	return result
+}
+
+// fieldColumnNumbers maps each Field constant to its 1-based column number in the HGNC file.
+var fieldColumnNumbers = map[Field]int{
+	FIELD_HGNC_ID:                  1,
+	FIELD_SYMBOL:                   2,
+	FIELD_NAME:                     3,
+	FIELD_LOCUS_GROUP:              4,
+	FIELD_LOCUS_TYPE:               5,
+	FIELD_STATUS:                   6,
+	FIELD_LOCATION:                 7,
+	FIELD_LOCATION_SORTABLE:        8,
+	FIELD_ALIAS_SYMBOL:             9,
+	FIELD_ALIAS_NAME:               10,
+	FIELD_PREV_SYMBOL:              11,
+	FIELD_PREV_NAME:                12,
+	FIELD_GENE_FAMILY:              13,
+	FIELD_GENE_FAMILY_ID:           14,
+	FIELD_DATE_APPROVED_RESERVED:   15,
+	FIELD_DATE_SYMBOL_CHANGED:      16,
+	FIELD_DATE_NAME_CHANGED:        17,
+	FIELD_DATE_MODIFIED:            18,
+	FIELD_ENTREZ_ID:                19,
+	FIELD_ENSEMBL_GENE_ID:          20,
+	FIELD_VEGA_ID:                  21,
+	FIELD_UCSC_ID:                  22,
+	FIELD_ENA:                      23,
+	FIELD_REFSEQ_ACCESSION:         24,
+	FIELD_CCDS_ID:                  25,
+	FIELD_UNIPROT_IDS:              26,
+	FIELD_PUBMED_ID:                27,
+	FIELD_MGD_ID:                   28,
+	FIELD_RGD_ID:                   29,
+	FIELD_LSDB:                     30,
+	FIELD_COSMIC:                   31,
+	FIELD_OMIM_ID:                  32,
+	FIELD_MIRBASE:                  33,
+	FIELD_HOMEODB:                  34,
+	FIELD_SNORNABASE:               35,
+	FIELD_BIOPARADIGMS_SLC:         36,
+	FIELD_ORPHANET:                 37,
+	FIELD_PSEUDOGENE_ORG:           38,
+	FIELD_HORDE_ID:                 39,
+	FIELD_MEROPS:                   40,
+	FIELD_IMGT:                     41,
+	FIELD_IUPHAR:                   42,
+	FIELD_KZNF_GENE_CATALOG:        43,
+	FIELD_MAMIT_TRNADB:             44,
+	FIELD_CD:                       45,
+	FIELD_LNCRNADB:                 46,
+	FIELD_ENZYME_ID:                47,
+	FIELD_INTERMEDIATE_FILAMENT_DB: 48,
+	FIELD_AGR:                      49,
+	FIELD_MANE_SELECT:              50,
 }
 
+// ColumnNumber returns the 1-based column number of the field in the HGNC file, 0 for unknown fields.
+func (f Field) ColumnNumber() int {
+	return fieldColumnNumbers[f]
+}
+
+// GetAllIndexedFields returns fields indexed by default when loading.
+func GetAllIndexedFields() []Field {
+	result := make([]Field, len(indexedFields))
+	copy(result, indexedFields)
+	return result
+}
+
+// GetAllIndexedFieldNames returns names of fields indexed by default when loading.
 func GetAllIndexedFieldNames() []string {
 	result := make([]string, len(indexedFields))
 	for i, f := range indexedFields {
@@ -79,6 +220,81 @@ func GetAllIndexedFieldNames() []string {
 	return result
 }
 
+// IsIndexed checks if the field currently has a built cache in h,
+// i.e. Fetch and Lookup on it are O(1) rather than a linear scan.
+// Lazily indexed fields return false until first used.
+func (f Field) IsIndexed(h *HGNC) bool {
+	if h == nil {
+		panic("HGNC is nil")
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.caches[f] != nil
+}
+
+// multiValueFields are fields that may hold multiple values delimited by "|".
+var multiValueFields = map[Field]bool{
+	FIELD_ALIAS_SYMBOL:     true,
+	FIELD_ALIAS_NAME:       true,
+	FIELD_PREV_SYMBOL:      true,
+	FIELD_PREV_NAME:        true,
+	FIELD_GENE_FAMILY:      true,
+	FIELD_GENE_FAMILY_ID:   true,
+	FIELD_ENA:              true,
+	FIELD_REFSEQ_ACCESSION: true,
+	FIELD_CCDS_ID:          true,
+	FIELD_UNIPROT_IDS:      true,
+	FIELD_PUBMED_ID:        true,
+	FIELD_MGD_ID:           true,
+	FIELD_RGD_ID:           true,
+	FIELD_LSDB:             true,
+	FIELD_OMIM_ID:          true,
+	FIELD_ENZYME_ID:        true,
+	FIELD_MANE_SELECT:      true,
+}
+
+// multiValueDelimiter separates values of multi-value fields.
+const multiValueDelimiter = "|"
+
+// IsMultiValueField checks if the field may hold multiple "|"-delimited values.
+func IsMultiValueField(f Field) bool {
+	return multiValueFields[f]
+}
+
+// Delimiter returns "|" for multi-value fields and "" for single-value ones.
+func Delimiter(f Field) string {
+	if multiValueFields[f] {
+		return multiValueDelimiter
+	}
+	return ""
+}
+
+// SplitMultiValue splits a "|"-delimited field value, trims spaces of each value
+// and drops empty ones. It returns nil for an empty string.
+func SplitMultiValue(raw string) []string {
+	if strings.TrimSpace(raw) == "" {
+		return nil
+	}
+	var values []string
+	for _, v := range strings.Split(raw, multiValueDelimiter) {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// IsMultiValue is the method form of IsMultiValueField.
+func (f Field) IsMultiValue() bool {
+	return IsMultiValueField(f)
+}
+
+// Delimiter is the method form of the package-level Delimiter.
+func (f Field) Delimiter() string {
+	return Delimiter(f)
+}
+
 var fieldDesc = map[Field]string{
 	FIELD_HGNC_ID:                  "HGNC ID. A unique ID created by the HGNC for every approved symbol.",
 	FIELD_SYMBOL:                   "The HGNC approved gene symbol. Equates to the \"APPROVED SYMBOL\" field within the gene symbol report.",
@@ -132,9 +348,20 @@ var fieldDesc = map[Field]string{
 	FIELD_MANE_SELECT:              "NCBI and Ensembl transcript IDs/acessions including the version number for one high-quality representative transcript per protein-coding gene that is well-supported by experimental data and represents the biology of the gene. The IDs are delimited by |.",
 }
 
+// GetFieldDesc is kept for backward compatibility, use field.Description() instead.
 func (h *HGNC) GetFieldDesc(field Field) string {
-	if desc, ok := fieldDesc[field]; ok {
+	return field.Description()
+}
+
+// Description returns the description of the field from HGNC, empty for unknown fields.
+func (f Field) Description() string {
+	if desc, ok := fieldDesc[f]; ok {
 		return desc
 	}
 	return ""
 }
+
+// String returns the column name of the field, e.g. "hgnc_id".
+func (f Field) String() string {
+	return string(f)
+}
@@ -0,0 +1,70 @@
+package hgnc_go_test
+
+import (
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestFieldIsIndexed(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		field   h.Field
+		prepare func(hgnc *h.HGNC)
+		want    bool
+	}{
+		{"indexed by default", h.FIELD_SYMBOL, func(*h.HGNC) {}, true},
+		{"lazy, unused", h.FIELD_VEGA_ID, func(*h.HGNC) {}, false},
+		{"lazy, after Fetch", h.FIELD_VEGA_ID, func(hgnc *h.HGNC) { hgnc.Fetch("OTTHUMG00000000001", h.FIELD_VEGA_ID) }, true},
+		{"lazy, after EnsureIndex", h.FIELD_LOCATION, func(hgnc *h.HGNC) { hgnc.EnsureIndex(h.FIELD_LOCATION) }, true},
+		{"removed", h.FIELD_ENTREZ_ID, func(hgnc *h.HGNC) { hgnc.RemoveIndex(h.FIELD_ENTREZ_ID) }, false},
+		{"removed then added", h.FIELD_ENTREZ_ID, func(hgnc *h.HGNC) {
+			hgnc.RemoveIndex(h.FIELD_ENTREZ_ID)
+			hgnc.AddIndex(h.FIELD_ENTREZ_ID)
+		}, true},
+		{"unknown field", h.Field("no_such_field"), func(*h.HGNC) {}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hgnc := testutil.NewSyntheticHGNC(10)
+			tt.prepare(hgnc)
+			if got := tt.field.IsIndexed(hgnc); got != tt.want {
+				t.Errorf("%s.IsIndexed() = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldDescription(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC()
+	tests := []struct {
+		field h.Field
+		want  string // prefix of the description
+	}{
+		{h.FIELD_HGNC_ID, "HGNC ID"},
+		{h.FIELD_MANE_SELECT, "NCBI and Ensembl transcript IDs"},
+		{h.Field("no_such_field"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field.String(), func(t *testing.T) {
+			got := tt.field.Description()
+			if !strings.HasPrefix(got, tt.want) || (tt.want == "") != (got == "") {
+				t.Errorf("Description() = %q, want it to start with %q", got, tt.want)
+			}
+			// the deprecated method is the same
+			if old := hgnc.GetFieldDesc(tt.field); old != got {
+				t.Errorf("GetFieldDesc() = %q, Description() = %q", old, got)
+			}
+		})
+	}
+
+	for _, field := range h.AllFields() {
+		if field.Description() == "" {
+			t.Errorf("%s has no description", field)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package hgnc_go
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// fuzzHeader is the first columns of the HGNC complete set header.
+const fuzzHeader = "hgnc_id\tsymbol\tname\talias_symbol\tprev_symbol\tentrez_id\tensembl_gene_id\n"
+
+// discardLogger keeps loads quiet while fuzzing.
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+func FuzzLoadFromReader(f *testing.F) {
+
+	longValue := strings.Repeat("A", 1<<16)
+	seeds := []string{
+		// empty file
+		"",
+		// header only
+		fuzzHeader,
+		// fewer columns than the header
+		fuzzHeader + "HGNC:11998\tTP53\n",
+		// extra columns
+		fuzzHeader + "HGNC:11998\tTP53\ttumor protein p53\tp53|LFS1\t\t7157\tENSG00000141510\textra\tcolumns\n",
+		// all fields empty
+		fuzzHeader + "\t\t\t\t\t\t\n",
+		// very long values
+		fuzzHeader + "HGNC:1\t" + longValue + "\t" + longValue + "\t" + longValue + "|" + longValue + "\t\t1\tENSG00000000001\n",
+	}
+	for _, seed := range seeds {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		h, err := LoadFromReader(bytes.NewReader(data), WithLogger(discardLogger))
+		if err != nil {
+			return
+		}
+		// a loaded database must be queryable
+		for _, field := range indexedFields {
+			h.Fetch("TP53", field)
+		}
+		if n := len(h.Filter(func(*Record) bool { return true })); n != h.RecordCount() {
+			t.Fatalf("Filter returned %d records, RecordCount is %d", n, h.RecordCount())
+		}
+	})
+}
+
+func FuzzClassify(f *testing.F) {
+
+	seeds := []string{"HGNC:1", "ENSG00000000001", "uc001aab.1", "12345", "TP53", "", strings.Repeat("9", 1<<12)}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	valid := make(map[Field]struct{}, len(allFields))
+	for _, field := range allFields {
+		valid[field] = struct{}{}
+	}
+
+	f.Fuzz(func(t *testing.T, gene string) {
+		field := classifyGeneStringSystem(gene)
+		if _, ok := valid[field]; !ok {
+			t.Fatalf("classifyGeneStringSystem(%q) returned unknown field %q", gene, field)
+		}
+	})
+}
+
+func FuzzNormalize(f *testing.F) {
+
+	seeds := []string{"TP53", "p53", " p53 ", "\tBRCC1X\n", "OLDX", "", " ", strings.Repeat("A", 1<<12)}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	h, err := LoadFromReader(strings.NewReader(fuzzHeader), WithLogger(discardLogger))
+	if err != nil {
+		f.Fatal(err)
+	}
+	h.AddRecord(NewRecordBuilder().WithHgncID("HGNC:11998").WithSymbol("TP53").WithAliasSymbols("p53", "LFS1").Build())
+	h.AddRecord(NewRecordBuilder().WithHgncID("HGNC:1100").WithSymbol("BRCA1").WithPrevSymbols("BRCC1X").Build())
+
+	f.Fuzz(func(t *testing.T, symbol string) {
+		// Fetch normalizes symbol queries with normalizeSymbol, surrounding spaces don't matter
+		records := h.Fetch(symbol, FIELD_SYMBOL)
+		if trimmed := h.Fetch(strings.TrimSpace(symbol), FIELD_SYMBOL); len(records) != len(trimmed) {
+			t.Fatalf("Fetch(%q) returned %d records, %d for the trimmed symbol", symbol, len(records), len(trimmed))
+		}
+		if normalized := h.normalizeSymbol(symbol); normalized != strings.TrimSpace(normalized) {
+			t.Fatalf("normalizeSymbol(%q) = %q, not trimmed", symbol, normalized)
+		}
+	})
+}
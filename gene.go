@@ -1,6 +1,8 @@
 package hgnc_go
 
 import (
+	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -31,16 +33,104 @@ func classifyGeneStringSystem(gene string) Field {
 	}
 }
 
+// classifyGene is classifyGeneStringSystem returning the value to query too,
+// ensembl gene ids are prepared by ensgKey (see SetEnsgVersionStripping)
+func (h *HGNC) classifyGene(gene string) (string, Field) {
+	field := classifyGeneStringSystem(gene)
+	if field == FIELD_ENSEMBL_GENE_ID {
+		gene = h.ensgKey(gene)
+	}
+	return gene, field
+}
+
+var (
+	refseqAccessionPattern = regexp.MustCompile(`^N[MRP]_\d+(\.\d+)?$`)
+	uniprotPattern         = regexp.MustCompile(`^[OPQ][0-9][A-Z0-9]{3}[0-9]$`)
+	omimPattern            = regexp.MustCompile(`^\d{6}$`)
+)
+
+// InferGeneIDSystem classifies a gene identifier and returns the field it belongs to.
+// Besides the systems of classifyGeneStringSystem, it recognizes:
+//   - RefSeq accessions (NM_/NR_/NP_) -> FIELD_REFSEQ_ACCESSION
+//   - UniProt accessions (e.g. P04637) -> FIELD_UNIPROT_IDS
+//   - OMIM IDs (six-digit numbers)     -> FIELD_OMIM_ID
+//
+// Note that six-digit numbers are taken as OMIM IDs, although some Entrez IDs have
+// six digits too. High-level APIs keep using classifyGeneStringSystem for that reason.
+func InferGeneIDSystem(identifier string) Field {
+	identifier = strings.TrimSpace(identifier)
+	switch {
+	case refseqAccessionPattern.MatchString(identifier):
+		return FIELD_REFSEQ_ACCESSION
+	case uniprotPattern.MatchString(identifier):
+		return FIELD_UNIPROT_IDS
+	case omimPattern.MatchString(identifier):
+		return FIELD_OMIM_ID
+	default:
+		return classifyGeneStringSystem(identifier)
+	}
+}
+
+// ResolveGene gets the record of a gene given in any supported id system.
+// If several records match, the first one is returned and a warning is logged.
+func (h *HGNC) ResolveGene(identifier string) (*Record, error) {
+	records, err := h.ResolveGeneAll(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 1 {
+		h.log().Warn("hgnc: identifier matches multiple records, using the first",
+			"identifier", identifier, "count", len(records))
+	}
+	return records[0], nil
+}
+
+// ResolveGeneAll gets all records of a gene given in any supported id system
+func (h *HGNC) ResolveGeneAll(identifier string) ([]*Record, error) {
+	identifier, field := h.classifyGene(identifier)
+	if records := h.Fetch(identifier, field); len(records) > 0 {
+		return records, nil
+	}
+	return nil, fmt.Errorf("%s %q: %w", field, identifier, ErrNotFound)
+}
+
 // GetManeSelect gets mane select transcript for a gene
 func (h *HGNC) GetManeSelect(gene string) (string, bool) {
-	field := classifyGeneStringSystem(gene)
+	gene, field := h.classifyGene(gene)
 	if result := h.Lookup(gene, field, FIELD_MANE_SELECT); len(result) > 0 {
 		return result[0], true
 	}
 	return "", false
 }
 
-// GetManeSelectENST gets mane select transcript for a gene and returns only the ENST id
+// GetManeSelectForEnsg gets mane select transcript for an ensembl gene id, skipping classification
+func (h *HGNC) GetManeSelectForEnsg(ensg string) (string, bool) {
+	ensg = h.ensgKey(ensg)
+	if result := h.Lookup(ensg, FIELD_ENSEMBL_GENE_ID, FIELD_MANE_SELECT); len(result) > 0 && result[0] != "" {
+		return result[0], true
+	}
+	return "", false
+}
+
+// GetManeSelectForEntrez gets mane select transcript for an entrez id, skipping classification
+func (h *HGNC) GetManeSelectForEntrez(entrezID string) (string, bool) {
+	if result := h.Lookup(entrezID, FIELD_ENTREZ_ID, FIELD_MANE_SELECT); len(result) > 0 && result[0] != "" {
+		return result[0], true
+	}
+	return "", false
+}
+
+// HasManeSelect checks if a gene has a mane select transcript
+func (h *HGNC) HasManeSelect(gene string) bool {
+	gene, field := h.classifyGene(gene)
+	if records := h.Fetch(gene, field); len(records) > 0 {
+		return records[0].HasManeSelect()
+	}
+	return false
+}
+
+// GetManeSelectENST gets mane select transcript for a gene and returns only the ENST id,
+// with version suffix (e.g. "ENST00000269305.9"), same as GetManeSelectENSTVersioned
 func (h *HGNC) GetManeSelectENST(gene string) (string, bool) {
 	result, found := h.GetManeSelect(gene)
 	if found {
@@ -49,7 +139,8 @@ func (h *HGNC) GetManeSelectENST(gene string) (string, bool) {
 	return "", false
 }
 
-// GetManeSelectRefseq gets mane select transcript for a gene and returns only the RefSeq id
+// GetManeSelectRefseq gets mane select transcript for a gene and returns only the RefSeq id,
+// with version suffix (e.g. "NM_000546.6"), same as GetManeSelectRefseqVersioned
 func (h *HGNC) GetManeSelectRefseq(gene string) (string, bool) {
 	result, found := h.GetManeSelect(gene)
 	if found {
@@ -61,17 +152,206 @@ func (h *HGNC) GetManeSelectRefseq(gene string) (string, bool) {
 	return "", false
 }
 
+/*
+The "version" of a transcript id is the ".N" suffix, e.g. "ENST00000380152.8" is
+version 8 of the bare accession "ENST00000380152". Versioned ids pin the exact
+sequence, bare ids are stable across releases.
+*/
+
+// GetManeSelectENSTVersioned gets the mane select ENST id with version suffix, e.g. "ENST00000380152.8"
+func (h *HGNC) GetManeSelectENSTVersioned(gene string) (string, bool) {
+	return h.GetManeSelectENST(gene)
+}
+
+// GetManeSelectENSTBare gets the mane select ENST id without version suffix, e.g. "ENST00000380152"
+func (h *HGNC) GetManeSelectENSTBare(gene string) (string, bool) {
+	if enst, found := h.GetManeSelectENST(gene); found {
+		return stripVersion(enst), true
+	}
+	return "", false
+}
+
+// GetManeSelectRefseqVersioned gets the mane select RefSeq id with version suffix, e.g. "NM_000059.4"
+func (h *HGNC) GetManeSelectRefseqVersioned(gene string) (string, bool) {
+	return h.GetManeSelectRefseq(gene)
+}
+
+// GetManeSelectRefseqBare gets the mane select RefSeq id without version suffix, e.g. "NM_000059"
+func (h *HGNC) GetManeSelectRefseqBare(gene string) (string, bool) {
+	if refseq, found := h.GetManeSelectRefseq(gene); found {
+		return stripVersion(refseq), true
+	}
+	return "", false
+}
+
+// GetManeSelectTranscriptType gets the type of the mane select RefSeq transcript of a gene,
+// "NM" (mRNA) or "NR" (non-coding RNA), false if there is no mane select RefSeq transcript
+func (h *HGNC) GetManeSelectTranscriptType(gene string) (string, bool) {
+	if refseq, found := h.GetManeSelectRefseq(gene); found {
+		switch prefix, _, _ := strings.Cut(refseq, "_"); prefix {
+		case "NM", "NR":
+			return prefix, true
+		}
+	}
+	return "", false
+}
+
+// GetManeSelectAll gets mane select transcripts (e.g. "ENST00000269305.9|NM_000546.6") for many genes,
+// genes not found or without mane select are absent from the result
+func (h *HGNC) GetManeSelectAll(genes []string) map[string]string {
+	results := make(map[string]string, len(genes))
+	for _, gene := range genes {
+		if result, found := h.GetManeSelect(gene); found && result != "" {
+			results[gene] = result
+		}
+	}
+	return results
+}
+
+// GetManeSelectAllENST is like GetManeSelectAll, but returns only the ENST ids
+func (h *HGNC) GetManeSelectAllENST(genes []string) map[string]string {
+	return splitManeSelectAll(h.GetManeSelectAll(genes), 0)
+}
+
+// GetManeSelectAllRefseq is like GetManeSelectAll, but returns only the RefSeq ids
+func (h *HGNC) GetManeSelectAllRefseq(genes []string) map[string]string {
+	return splitManeSelectAll(h.GetManeSelectAll(genes), 1)
+}
+
+// splitManeSelectAll keeps the i-th "|" separated id of mane select transcripts
+func splitManeSelectAll(maneSelects map[string]string, i int) map[string]string {
+	results := make(map[string]string, len(maneSelects))
+	for gene, maneSelect := range maneSelects {
+		if split := strings.Split(maneSelect, "|"); len(split) > i && split[i] != "" {
+			results[gene] = split[i]
+		}
+	}
+	return results
+}
+
+// FetchByRefseqAccession fetches records of a refseq accession, ignoring its version suffix
+// (e.g. "NM_007294.4" is the same as "NM_007294")
+func (h *HGNC) FetchByRefseqAccession(accession string) []*Record {
+	return h.Fetch(stripVersion(strings.TrimSpace(accession)), FIELD_REFSEQ_ACCESSION)
+}
+
+// LookupByOmimID fetches records of an omim id, given as "191170", "MIM:191170" or "OMIM:191170"
+func (h *HGNC) LookupByOmimID(omimID string) []*Record {
+	omimID = strings.TrimSpace(omimID)
+	for _, prefix := range []string{"OMIM:", "MIM:"} {
+		if len(omimID) >= len(prefix) && strings.EqualFold(omimID[:len(prefix)], prefix) {
+			omimID = strings.TrimSpace(omimID[len(prefix):])
+			break
+		}
+	}
+	return h.Fetch(omimID, FIELD_OMIM_ID)
+}
+
+// GetAllWithOmimID gets all records having an omim id, in load order
+func (h *HGNC) GetAllWithOmimID() []*Record {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	results := make([]*Record, 0)
+	for recordIdx, ok := range h.hasOmimID() {
+		if ok {
+			results = append(results, h.records[recordIdx])
+		}
+	}
+	return results
+}
+
+// CountWithOmimID counts records having an omim id
+func (h *HGNC) CountWithOmimID() int {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	count := 0
+	for _, ok := range h.hasOmimID() {
+		if ok {
+			count++
+		}
+	}
+	return count
+}
+
+// hasOmimID tells for each record (by index of h.records) whether it has an omim id,
+// from the omim id cache keys if indexed
+func (h *HGNC) hasOmimID() []bool {
+	marks := make([]bool, len(h.records))
+	if cache := h.caches[FIELD_OMIM_ID]; cache != nil {
+		for _, indexes := range cache {
+			for _, recordIdx := range indexes {
+				marks[recordIdx] = true
+			}
+		}
+		return marks
+	}
+	for recordIdx, record := range h.records {
+		marks[recordIdx] = len(cacheKeys(FIELD_OMIM_ID, record.data[FIELD_OMIM_ID])) > 0
+	}
+	return marks
+}
+
+// stripVersion removes the version suffix (".N") of an accession
+func stripVersion(accession string) string {
+	return strings.Split(accession, ".")[0]
+}
+
 // IsCodingGene checks if a gene is protein-coding by it's locus group
 func (h *HGNC) IsCodingGene(gene string) bool {
-	field := classifyGeneStringSystem(gene)
+	gene, field := h.classifyGene(gene)
 	if result := h.Lookup(gene, field, FIELD_LOCUS_GROUP); len(result) > 0 {
-		if strings.HasPrefix(result[0], "protein-coding") {
+		if isCodingLocusGroup(result[0]) {
 			return true
 		}
 	}
 	return false
 }
 
+// FetchCodingGenes gets all protein-coding gene records (same rule as IsCodingGene)
+func (h *HGNC) FetchCodingGenes() []*Record {
+	return h.Filter(func(r *Record) bool {
+		return isCodingLocusGroup(r.data[FIELD_LOCUS_GROUP])
+	})
+}
+
+// FetchPseudogenes gets all pseudogene records
+func (h *HGNC) FetchPseudogenes() []*Record {
+	return h.Filter(func(r *Record) bool {
+		return isPseudogeneLocusGroup(r.data[FIELD_LOCUS_GROUP])
+	})
+}
+
+// FetchNonCodingGenes gets all records that are neither protein-coding genes nor pseudogenes
+// (non-coding RNA, other, withdrawn entries, ...)
+func (h *HGNC) FetchNonCodingGenes() []*Record {
+	return h.Filter(func(r *Record) bool {
+		locusGroup := r.data[FIELD_LOCUS_GROUP]
+		return !isCodingLocusGroup(locusGroup) && !isPseudogeneLocusGroup(locusGroup)
+	})
+}
+
+// isCodingLocusGroup checks if a locus group is protein-coding
+func isCodingLocusGroup(locusGroup string) bool {
+	return LocusGroup(locusGroup) == LocusGroupProteinCoding
+}
+
+// isPseudogeneLocusGroup checks if a locus group is pseudogene
+func isPseudogeneLocusGroup(locusGroup string) bool {
+	return LocusGroup(locusGroup) == LocusGroupPseudogene
+}
+
 // EntrezIDToSymbol converts entrez id to gene symbol
 func (h *HGNC) EntrezIDToSymbol(entrezID string) (string, bool) {
 	if result := h.Lookup(entrezID, FIELD_ENTREZ_ID, FIELD_SYMBOL); len(result) > 0 {
@@ -90,7 +370,7 @@ func (h *HGNC) SymbolToEntrezID(symbol string) (string, bool) {
 
 // EnsgToSymbol converts ensembl gene id to gene symbol
 func (h *HGNC) EnsgToSymbol(ensg string) (string, bool) {
-	ensg = strings.Split(ensg, ".")[0]
+	ensg = h.ensgKey(ensg)
 	if result := h.Lookup(ensg, FIELD_ENSEMBL_GENE_ID, FIELD_SYMBOL); len(result) > 0 {
 		return result[0], true
 	}
@@ -121,10 +401,132 @@ func (h *HGNC) SymbolToUcscID(symbol string) (string, bool) {
 	return "", false
 }
 
-// GeneRefseqAccs gets refseq accessions for a gene
+// SymbolToLocation converts gene symbol to cytogenetic location (e.g. "17p13.1")
+func (h *HGNC) SymbolToLocation(symbol string) (string, bool) {
+	if result := h.Lookup(symbol, FIELD_SYMBOL, FIELD_LOCATION); len(result) > 0 && result[0] != "" {
+		return result[0], true
+	}
+	return "", false
+}
+
+// SymbolToLocationSortable converts gene symbol to sortable cytogenetic location (e.g. "17p13.1")
+func (h *HGNC) SymbolToLocationSortable(symbol string) (string, bool) {
+	if result := h.Lookup(symbol, FIELD_SYMBOL, FIELD_LOCATION_SORTABLE); len(result) > 0 && result[0] != "" {
+		return result[0], true
+	}
+	return "", false
+}
+
+// UcscIDToEnsg converts ucsc id to ensembl gene id
+func (h *HGNC) UcscIDToEnsg(ucscID string) (string, bool) {
+	if result := h.Lookup(ucscID, FIELD_UCSC_ID, FIELD_ENSEMBL_GENE_ID); len(result) > 0 && result[0] != "" {
+		return result[0], true
+	}
+	return "", false
+}
+
+// EnsgToUcscID converts ensembl gene id to ucsc id
+func (h *HGNC) EnsgToUcscID(ensg string) (string, bool) {
+	ensg = h.ensgKey(ensg)
+	if result := h.Lookup(ensg, FIELD_ENSEMBL_GENE_ID, FIELD_UCSC_ID); len(result) > 0 && result[0] != "" {
+		return result[0], true
+	}
+	return "", false
+}
+
+// UniprotIDToSymbol converts a single uniprot accession (e.g. "P04637") to gene symbol
+func (h *HGNC) UniprotIDToSymbol(accession string) (string, bool) {
+	if result := h.Lookup(strings.TrimSpace(accession), FIELD_UNIPROT_IDS, FIELD_SYMBOL); len(result) > 0 {
+		return result[0], true
+	}
+	return "", false
+}
+
+// ENAAccessionToSymbol converts a single ena accession (e.g. "AF307851") to gene symbol
+func (h *HGNC) ENAAccessionToSymbol(accession string) (string, bool) {
+	if result := h.Lookup(strings.TrimSpace(accession), FIELD_ENA, FIELD_SYMBOL); len(result) > 0 {
+		return result[0], true
+	}
+	return "", false
+}
+
+// SymbolToCCDSIDs converts gene symbol to all its ccds ids (e.g. ["CCDS11118", "CCDS45605"])
+func (h *HGNC) SymbolToCCDSIDs(symbol string) []string {
+	if result := h.Lookup(symbol, FIELD_SYMBOL, FIELD_CCDS_ID); len(result) > 0 {
+		if ids := SplitMultiValue(result[0]); ids != nil {
+			return ids
+		}
+	}
+	return make([]string, 0)
+}
+
+// CCDSIDToSymbol converts a single ccds id to gene symbol. Ccds ids are indexed without
+// version, so the version suffix of input is stripped ("CCDS11118.1" is "CCDS11118").
+func (h *HGNC) CCDSIDToSymbol(ccdsID string) (string, bool) {
+	ccdsID = stripVersion(strings.TrimSpace(ccdsID))
+	if result := h.Lookup(ccdsID, FIELD_CCDS_ID, FIELD_SYMBOL); len(result) > 0 {
+		return result[0], true
+	}
+	return "", false
+}
+
+// SymbolToMirbaseID converts gene symbol to mirbase id (e.g. "MI0000077")
+func (h *HGNC) SymbolToMirbaseID(symbol string) (string, bool) {
+	if result := h.Lookup(symbol, FIELD_SYMBOL, FIELD_MIRBASE); len(result) > 0 && result[0] != "" {
+		return result[0], true
+	}
+	return "", false
+}
+
+// MirbaseIDToSymbol converts mirbase id to gene symbol
+func (h *HGNC) MirbaseIDToSymbol(mirbaseID string) (string, bool) {
+	if result := h.Lookup(strings.TrimSpace(mirbaseID), FIELD_MIRBASE, FIELD_SYMBOL); len(result) > 0 {
+		return result[0], true
+	}
+	return "", false
+}
+
+// BatchMirbaseIDToSymbol converts many mirbase ids to gene symbols at once,
+// ids not found are absent from the result
+func (h *HGNC) BatchMirbaseIDToSymbol(ids []string) map[string]string {
+	results := make(map[string]string, len(ids))
+	for id, records := range h.BatchFetch(ids, FIELD_MIRBASE) {
+		if len(records) > 0 {
+			results[id] = records[0].data[FIELD_SYMBOL]
+		}
+	}
+	return results
+}
+
+// SymbolToSnoRNABaseID converts gene symbol to snornabase id (e.g. "SR0000178")
+func (h *HGNC) SymbolToSnoRNABaseID(symbol string) (string, bool) {
+	if result := h.Lookup(symbol, FIELD_SYMBOL, FIELD_SNORNABASE); len(result) > 0 && result[0] != "" {
+		return result[0], true
+	}
+	return "", false
+}
+
+// SnoRNABaseIDToSymbol converts snornabase id to gene symbol
+func (h *HGNC) SnoRNABaseIDToSymbol(snoRNABaseID string) (string, bool) {
+	if result := h.Lookup(strings.TrimSpace(snoRNABaseID), FIELD_SNORNABASE, FIELD_SYMBOL); len(result) > 0 {
+		return result[0], true
+	}
+	return "", false
+}
+
+// GeneRefseqAccs gets refseq accessions for a gene, version suffixes of
+// ensembl gene ids and refseq accessions on input are ignored
 func (h *HGNC) GeneRefseqAccs(gene string) (string, bool) {
 
-	field := classifyGeneStringSystem(gene)
+	gene = strings.TrimSpace(gene)
+	if refseqAccessionPattern.MatchString(gene) {
+		if records := h.FetchByRefseqAccession(gene); len(records) > 0 {
+			return records[0].data[FIELD_REFSEQ_ACCESSION], true
+		}
+		return "", false
+	}
+
+	gene, field := h.classifyGene(gene)
 	if result := h.Lookup(gene, field, FIELD_REFSEQ_ACCESSION); len(result) > 0 {
 		return result[0], true
 	}
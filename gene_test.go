@@ -0,0 +1,449 @@
+package hgnc_go_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestVersionedEnsg(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53())
+
+	tests := []struct {
+		name  string
+		found func(gene string) bool
+	}{
+		{"ResolveGeneAll", func(gene string) bool {
+			records, err := hgnc.ResolveGeneAll(gene)
+			return err == nil && len(records) == 1
+		}},
+		{"GetManeSelect", func(gene string) bool { _, ok := hgnc.GetManeSelect(gene); return ok }},
+		{"HasManeSelect", hgnc.HasManeSelect},
+		{"IsCodingGene", hgnc.IsCodingGene},
+		{"GetHomologs", func(gene string) bool { _, ok := hgnc.GetHomologs(gene); return ok }},
+		{"GetAlternativeIDs", func(gene string) bool { _, ok := hgnc.GetAlternativeIDs(gene); return ok }},
+		{"AnnotateVCFGene", func(gene string) bool { return hgnc.AnnotateVCFGene(gene) != nil }},
+		{"GeneRefseqAccs", func(gene string) bool { _, ok := hgnc.GeneRefseqAccs(gene); return ok }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, gene := range []string{"ENSG00000141510", "ENSG00000141510.17"} {
+				if !tt.found(gene) {
+					t.Errorf("%s(%q) didn't find TP53", tt.name, gene)
+				}
+			}
+		})
+	}
+
+	hgnc.SetEnsgVersionStripping(false)
+	for _, tt := range tests {
+		if tt.found("ENSG00000141510.17") {
+			t.Errorf("%s found a versioned id with version stripping disabled", tt.name)
+		}
+	}
+}
+
+// newGeneHGNC returns a mock database of TP53, BRCA1 and MIR21 (no MANE Select).
+func newGeneHGNC() *h.HGNC {
+	return testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1(), testutil.FixtureMIR21())
+}
+
+func TestGetManeSelectForEnsgAndEntrez(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+
+	tests := []struct {
+		name      string
+		get       func(string) (string, bool)
+		id        string
+		want      string
+		wantFound bool
+	}{
+		{"ensg", hgnc.GetManeSelectForEnsg, "ENSG00000012048", "ENST00000357654.9|NM_007294.4", true},
+		{"versioned ensg", hgnc.GetManeSelectForEnsg, "ENSG00000141510.17", "ENST00000269305.9|NM_000546.6", true},
+		{"ensg without mane select", hgnc.GetManeSelectForEnsg, "ENSG00000284190", "", false},
+		{"unknown ensg", hgnc.GetManeSelectForEnsg, "ENSG00000000001", "", false},
+		{"entrez", hgnc.GetManeSelectForEntrez, "7157", "ENST00000269305.9|NM_000546.6", true},
+		{"entrez without mane select", hgnc.GetManeSelectForEntrez, "406991", "", false},
+		{"unknown entrez", hgnc.GetManeSelectForEntrez, "1", "", false},
+		{"symbol is not an entrez id", hgnc.GetManeSelectForEntrez, "TP53", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := tt.get(tt.id)
+			if got != tt.want || found != tt.wantFound {
+				t.Errorf("got (%q, %v), want (%q, %v)", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestHasManeSelect(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+
+	tests := []struct {
+		gene string
+		want bool
+	}{
+		{"TP53", true},
+		{"p53", true},
+		{"HGNC:1100", true},
+		{"672", true},
+		{"ENSG00000141510", true},
+		{"MIR21", false},
+		{"HGNC:31586", false},
+		{"NOPE", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.gene, func(t *testing.T) {
+			if got := hgnc.HasManeSelect(tt.gene); got != tt.want {
+				t.Errorf("HasManeSelect(%q) = %v, want %v", tt.gene, got, tt.want)
+			}
+			if _, found := hgnc.GetManeSelect(tt.gene); tt.want && !found {
+				t.Errorf("GetManeSelect(%q) not found, but HasManeSelect is true", tt.gene)
+			}
+		})
+	}
+}
+
+func TestFetchGenesByClass(t *testing.T) {
+
+	hgnc := testutil.NewSyntheticHGNC(8) // locus groups cycle: protein-coding, non-coding RNA, pseudogene, other
+
+	tests := []struct {
+		name  string
+		fetch func() []*h.Record
+		want  []string
+	}{
+		{"coding", hgnc.FetchCodingGenes, []string{"GENE0", "GENE4"}},
+		{"pseudogenes", hgnc.FetchPseudogenes, []string{"GENE2", "GENE6"}},
+		{"non-coding", hgnc.FetchNonCodingGenes, []string{"GENE1", "GENE3", "GENE5", "GENE7"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := symbols(tt.fetch()); !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for _, record := range hgnc.FetchCodingGenes() {
+		if !hgnc.IsCodingGene(record.Symbol()) {
+			t.Errorf("IsCodingGene(%q) = false for a record of FetchCodingGenes", record.Symbol())
+		}
+	}
+	if empty := testutil.NewMockHGNC(); empty.FetchCodingGenes() == nil || empty.FetchNonCodingGenes() == nil {
+		t.Error("fetching from an empty database returned nil")
+	}
+}
+
+func TestInferGeneIDSystem(t *testing.T) {
+
+	tests := []struct {
+		identifier string
+		want       h.Field
+	}{
+		{"HGNC:11998", h.FIELD_HGNC_ID},
+		{"ENSG00000141510", h.FIELD_ENSEMBL_GENE_ID},
+		{"ENSG00000141510.17", h.FIELD_ENSEMBL_GENE_ID},
+		{"uc060aur.1", h.FIELD_UCSC_ID},
+		{"7157", h.FIELD_ENTREZ_ID},
+		{"191170", h.FIELD_OMIM_ID},
+		{"NM_000546", h.FIELD_REFSEQ_ACCESSION},
+		{"NM_000546.6", h.FIELD_REFSEQ_ACCESSION},
+		{"NR_029493", h.FIELD_REFSEQ_ACCESSION},
+		{"P04637", h.FIELD_UNIPROT_IDS},
+		{" P04637 ", h.FIELD_UNIPROT_IDS},
+		{"TP53", h.FIELD_SYMBOL},
+		{"p53", h.FIELD_SYMBOL},
+		{"NM_", h.FIELD_SYMBOL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.identifier, func(t *testing.T) {
+			if got := h.InferGeneIDSystem(tt.identifier); got != tt.want {
+				t.Errorf("InferGeneIDSystem(%q) = %s, want %s", tt.identifier, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchByRefseqAccession(t *testing.T) {
+
+	several := h.NewRecordBuilder().WithHgncID("HGNC:5").WithSymbol("SEVERAL").WithRefseqAccession("NM_000001|NR_000002").Build()
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1(), several)
+
+	tests := []struct {
+		accession string
+		want      []string
+	}{
+		{"NM_007294", []string{"BRCA1"}},
+		{"NM_007294.4", []string{"BRCA1"}},
+		{" NM_000546.6 ", []string{"TP53"}},
+		{"NR_000002.1", []string{"SEVERAL"}},
+		{"NM_000001", []string{"SEVERAL"}},
+		{"NM_999999", []string{}},
+		{"", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accession, func(t *testing.T) {
+			if got := symbols(hgnc.FetchByRefseqAccession(tt.accession)); !slices.Equal(got, tt.want) {
+				t.Errorf("FetchByRefseqAccession(%q) = %q, want %q", tt.accession, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupByOmimID(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+
+	tests := []struct {
+		omimID string
+		want   []string
+	}{
+		{"191170", []string{"TP53"}},
+		{"MIM:191170", []string{"TP53"}},
+		{"mim:191170", []string{"TP53"}},
+		{"OMIM:113705", []string{"BRCA1"}},
+		{" MIM: 113705 ", []string{"BRCA1"}},
+		{"MIM:999999", []string{}},
+		{"MIM:", []string{}},
+		{"", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.omimID, func(t *testing.T) {
+			if got := symbols(hgnc.LookupByOmimID(tt.omimID)); !slices.Equal(got, tt.want) {
+				t.Errorf("LookupByOmimID(%q) = %q, want %q", tt.omimID, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSymbolToCCDSIDs(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+
+	tests := []struct {
+		symbol string
+		want   []string
+	}{
+		{"TP53", []string{"CCDS11118", "CCDS45605"}},
+		{"p53", []string{"CCDS11118", "CCDS45605"}},
+		{"BRCA1", []string{}},
+		{"NOPE", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			if got := hgnc.SymbolToCCDSIDs(tt.symbol); got == nil || !slices.Equal(got, tt.want) {
+				t.Errorf("SymbolToCCDSIDs(%q) = %#v, want %q", tt.symbol, got, tt.want)
+			}
+		})
+	}
+
+	for _, id := range []string{"CCDS45605", "CCDS45605.1", " CCDS11118 "} {
+		if got, found := hgnc.CCDSIDToSymbol(id); got != "TP53" || !found {
+			t.Errorf("CCDSIDToSymbol(%q) = (%q, %v), want TP53", id, got, found)
+		}
+	}
+	if got, found := hgnc.CCDSIDToSymbol("CCDS1"); found {
+		t.Errorf("CCDSIDToSymbol(CCDS1) = %q, want not found", got)
+	}
+}
+
+// converterTest is a case of a (string, bool) converter such as SymbolToMirbaseID.
+type converterTest struct {
+	name      string
+	convert   func(string) (string, bool)
+	input     string
+	want      string
+	wantFound bool
+}
+
+func runConverterTests(t *testing.T, tests []converterTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := tt.convert(tt.input)
+			if got != tt.want || found != tt.wantFound {
+				t.Errorf("convert(%q) = (%q, %v), want (%q, %v)", tt.input, got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestMirbaseConverters(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+	runConverterTests(t, []converterTest{
+		{"symbol", hgnc.SymbolToMirbaseID, "MIR21", "MI0000077", true},
+		{"alias", hgnc.SymbolToMirbaseID, "hsa-mir-21", "MI0000077", true},
+		{"symbol without mirbase id", hgnc.SymbolToMirbaseID, "TP53", "", false},
+		{"unknown symbol", hgnc.SymbolToMirbaseID, "NOPE", "", false},
+		{"mirbase id", hgnc.MirbaseIDToSymbol, "MI0000077", "MIR21", true},
+		{"mirbase id with spaces", hgnc.MirbaseIDToSymbol, " MI0000077 ", "MIR21", true},
+		{"unknown mirbase id", hgnc.MirbaseIDToSymbol, "MI0000001", "", false},
+	})
+
+	got := hgnc.BatchMirbaseIDToSymbol([]string{"MI0000077", "MI0000001"})
+	if len(got) != 1 || got["MI0000077"] != "MIR21" {
+		t.Errorf("BatchMirbaseIDToSymbol() = %v, want only MI0000077 -> MIR21", got)
+	}
+}
+
+func TestSnoRNABaseConverters(t *testing.T) {
+
+	snord := h.NewRecordBuilder().WithHgncID("HGNC:10150").WithSymbol("SNORD3A").WithAliasSymbols("U3").
+		With(h.FIELD_SNORNABASE, "SR0000001").Build()
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), snord)
+
+	runConverterTests(t, []converterTest{
+		{"symbol", hgnc.SymbolToSnoRNABaseID, "SNORD3A", "SR0000001", true},
+		{"alias", hgnc.SymbolToSnoRNABaseID, "U3", "SR0000001", true},
+		{"symbol without snornabase id", hgnc.SymbolToSnoRNABaseID, "TP53", "", false},
+		{"unknown symbol", hgnc.SymbolToSnoRNABaseID, "NOPE", "", false},
+		{"snornabase id", hgnc.SnoRNABaseIDToSymbol, "SR0000001", "SNORD3A", true},
+		{"snornabase id with spaces", hgnc.SnoRNABaseIDToSymbol, "\tSR0000001 ", "SNORD3A", true},
+		{"unknown snornabase id", hgnc.SnoRNABaseIDToSymbol, "SR0000002", "", false},
+	})
+}
+
+func TestGetManeSelectAll(t *testing.T) {
+
+	enstOnly := h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("ENSTONLY").WithManeSelect("ENST00000000001.1", "").Build()
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1(), testutil.FixtureMIR21(), enstOnly)
+
+	genes := []string{"TP53", "672", "MIR21", "ENSTONLY", "NOPE"}
+	tests := []struct {
+		name string
+		get  func([]string) map[string]string
+		want map[string]string
+	}{
+		{"transcripts", hgnc.GetManeSelectAll, map[string]string{
+			"TP53":     "ENST00000269305.9|NM_000546.6",
+			"672":      "ENST00000357654.9|NM_007294.4",
+			"ENSTONLY": "ENST00000000001.1|",
+		}},
+		{"enst", hgnc.GetManeSelectAllENST, map[string]string{
+			"TP53":     "ENST00000269305.9",
+			"672":      "ENST00000357654.9",
+			"ENSTONLY": "ENST00000000001.1",
+		}},
+		{"refseq", hgnc.GetManeSelectAllRefseq, map[string]string{
+			"TP53": "NM_000546.6",
+			"672":  "NM_007294.4",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.get(genes); !maps.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if got := tt.get(nil); len(got) != 0 {
+				t.Errorf("got %v for no genes, want none", got)
+			}
+		})
+	}
+}
+
+func TestGetManeSelectTranscriptType(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureMIR21(),
+		h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("NONCODING").WithManeSelect("ENST00000000001.1", "NR_000001.1").Build(),
+		h.NewRecordBuilder().WithHgncID("HGNC:2").WithSymbol("ENSTONLY").WithManeSelect("ENST00000000002.1", "").Build(),
+		h.NewRecordBuilder().WithHgncID("HGNC:3").WithSymbol("PREDICTED").WithManeSelect("ENST00000000003.1", "XM_000003.1").Build())
+
+	tests := []struct {
+		gene      string
+		want      string
+		wantFound bool
+	}{
+		{"TP53", "NM", true},
+		{"p53", "NM", true},
+		{"NONCODING", "NR", true},
+		{"ENSTONLY", "", false},
+		{"PREDICTED", "", false},
+		{"MIR21", "", false},
+		{"NOPE", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.gene, func(t *testing.T) {
+			got, found := hgnc.GetManeSelectTranscriptType(tt.gene)
+			if got != tt.want || found != tt.wantFound {
+				t.Errorf("GetManeSelectTranscriptType(%q) = (%q, %v), want (%q, %v)", tt.gene, got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestUcscConverters(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+	runConverterTests(t, []converterTest{
+		{"ucsc id", hgnc.UcscIDToEnsg, "uc060aur.1", "ENSG00000141510", true},
+		{"other ucsc id", hgnc.UcscIDToEnsg, "uc002ict.4", "ENSG00000012048", true},
+		{"unknown ucsc id", hgnc.UcscIDToEnsg, "uc000aaa.1", "", false},
+		{"ensg", hgnc.EnsgToUcscID, "ENSG00000141510", "uc060aur.1", true},
+		{"versioned ensg", hgnc.EnsgToUcscID, "ENSG00000141510.17", "uc060aur.1", true},
+		{"ensg without ucsc id", hgnc.EnsgToUcscID, "ENSG00000284190", "", false},
+		{"unknown ensg", hgnc.EnsgToUcscID, "ENSG00000000001", "", false},
+	})
+}
+
+func TestSymbolToLocation(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(),
+		h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("CENTROMERIC").
+			With(h.FIELD_LOCATION, "1q12").With(h.FIELD_LOCATION_SORTABLE, "01q12").Build(),
+		h.NewRecordBuilder().WithHgncID("HGNC:2").WithSymbol("NOLOCATION").Build())
+
+	runConverterTests(t, []converterTest{
+		{"location", hgnc.SymbolToLocation, "TP53", "17p13.1", true},
+		{"location of alias", hgnc.SymbolToLocation, "p53", "17p13.1", true},
+		{"location differing from sortable", hgnc.SymbolToLocation, "CENTROMERIC", "1q12", true},
+		{"no location", hgnc.SymbolToLocation, "NOLOCATION", "", false},
+		{"location of unknown symbol", hgnc.SymbolToLocation, "NOPE", "", false},
+		{"sortable", hgnc.SymbolToLocationSortable, "TP53", "17p13.1", true},
+		{"sortable differing from location", hgnc.SymbolToLocationSortable, "CENTROMERIC", "01q12", true},
+		{"no sortable location", hgnc.SymbolToLocationSortable, "NOLOCATION", "", false},
+		{"sortable of unknown symbol", hgnc.SymbolToLocationSortable, "NOPE", "", false},
+	})
+}
+
+func TestGetAllWithOmimID(t *testing.T) {
+
+	records := []*h.Record{
+		testutil.FixtureTP53(), testutil.FixtureMIR21(), testutil.FixtureBRCA1(),
+		h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("TWOMIM").WithOmimID("100001|100002").Build(),
+	}
+	indexed := testutil.NewMockHGNC(records...)
+	notIndexed := testutil.NewMockHGNC(records...)
+	notIndexed.RemoveIndex(h.FIELD_OMIM_ID)
+
+	tests := []struct {
+		name string
+		hgnc *h.HGNC
+		want []string
+	}{
+		{"indexed", indexed, []string{"TP53", "BRCA1", "TWOMIM"}},
+		{"not indexed", notIndexed, []string{"TP53", "BRCA1", "TWOMIM"}},
+		{"empty", testutil.NewMockHGNC(), []string{}},
+		{"no omim ids", testutil.NewMockHGNC(testutil.FixtureMIR21()), []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.hgnc.GetAllWithOmimID()
+			if got == nil || !slices.Equal(symbols(got), tt.want) {
+				t.Errorf("GetAllWithOmimID() = %q, want %q", symbols(got), tt.want)
+			}
+			if count := tt.hgnc.CountWithOmimID(); count != len(tt.want) {
+				t.Errorf("CountWithOmimID() = %d, want %d", count, len(tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package hgnc_go
+
+import "strings"
+
+// GeneIDs holds all identifiers of a gene, taken from its record.
+// Multi-value fields (e.g. RefseqAccessions) are pipe-delimited, as in the source file.
+type GeneIDs struct {
+	HgncID           string
+	Symbol           string
+	EntrezID         string
+	EnsemblID        string
+	UcscID           string
+	RefseqAccessions string
+	OmimID           string
+	UniprotIDs       string
+	MirbaseID        string
+	CcdsIDs          string
+}
+
+// GetAlternativeIDs gets all identifiers of a gene (hgnc id, ensembl id, entrez id, ucsc id or symbol)
+// at once. If several records match, the first one is used.
+func (h *HGNC) GetAlternativeIDs(gene string) (*GeneIDs, bool) {
+
+	gene = strings.TrimSpace(gene)
+	gene, field := h.classifyGene(gene)
+	records := h.Fetch(gene, field)
+	if len(records) == 0 {
+		return nil, false
+	}
+	record := records[0]
+
+	return &GeneIDs{
+		HgncID:           record.data[FIELD_HGNC_ID],
+		Symbol:           record.data[FIELD_SYMBOL],
+		EntrezID:         record.data[FIELD_ENTREZ_ID],
+		EnsemblID:        record.data[FIELD_ENSEMBL_GENE_ID],
+		UcscID:           record.data[FIELD_UCSC_ID],
+		RefseqAccessions: record.data[FIELD_REFSEQ_ACCESSION],
+		OmimID:           record.data[FIELD_OMIM_ID],
+		UniprotIDs:       record.data[FIELD_UNIPROT_IDS],
+		MirbaseID:        record.data[FIELD_MIRBASE],
+		CcdsIDs:          record.data[FIELD_CCDS_ID],
+	}, true
+}
+
+// String returns a compact summary of non-empty identifiers,
+// e.g. "TP53 (HGNC:11998, Entrez:7157, Ensembl:ENSG00000141510, ...)".
+func (g *GeneIDs) String() string {
+
+	if g == nil {
+		return "<nil>"
+	}
+
+	ids := make([]string, 0, 9)
+	for _, id := range []struct{ name, value string }{
+		{"", g.HgncID}, // already prefixed with "HGNC:"
+		{"Entrez", g.EntrezID},
+		{"Ensembl", g.EnsemblID},
+		{"UCSC", g.UcscID},
+		{"RefSeq", g.RefseqAccessions},
+		{"OMIM", g.OmimID},
+		{"UniProt", g.UniprotIDs},
+		{"miRBase", g.MirbaseID},
+		{"CCDS", g.CcdsIDs},
+	} {
+		switch {
+		case id.value == "":
+		case id.name == "":
+			ids = append(ids, id.value)
+		default:
+			ids = append(ids, id.name+":"+id.value)
+		}
+	}
+	return g.Symbol + " (" + strings.Join(ids, ", ") + ")"
+}
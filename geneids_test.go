@@ -0,0 +1,59 @@
+package hgnc_go_test
+
+import (
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+)
+
+func TestGetAlternativeIDs(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+	tp53 := h.GeneIDs{
+		HgncID:           "HGNC:11998",
+		Symbol:           "TP53",
+		EntrezID:         "7157",
+		EnsemblID:        "ENSG00000141510",
+		UcscID:           "uc060aur.1",
+		RefseqAccessions: "NM_000546",
+		OmimID:           "191170",
+		UniprotIDs:       "P04637",
+		CcdsIDs:          "CCDS11118|CCDS45605",
+	}
+	mir21 := h.GeneIDs{
+		HgncID:    "HGNC:31586",
+		Symbol:    "MIR21",
+		EntrezID:  "406991",
+		EnsemblID: "ENSG00000284190",
+		MirbaseID: "MI0000077",
+	}
+
+	tests := []struct {
+		gene       string
+		want       h.GeneIDs
+		wantFound  bool
+		wantString string
+	}{
+		{"TP53", tp53, true, "TP53 (HGNC:11998, Entrez:7157, Ensembl:ENSG00000141510, UCSC:uc060aur.1, RefSeq:NM_000546, " +
+			"OMIM:191170, UniProt:P04637, CCDS:CCDS11118|CCDS45605)"},
+		{"p53", tp53, true, ""},
+		{"HGNC:11998", tp53, true, ""},
+		{"7157", tp53, true, ""},
+		{"ENSG00000141510", tp53, true, ""},
+		{"uc060aur.1", tp53, true, ""},
+		{" TP53 ", tp53, true, ""},
+		{"MIR21", mir21, true, "MIR21 (HGNC:31586, Entrez:406991, Ensembl:ENSG00000284190, miRBase:MI0000077)"},
+		{"NOPE", h.GeneIDs{}, false, "<nil>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.gene, func(t *testing.T) {
+			got, found := hgnc.GetAlternativeIDs(tt.gene)
+			if found != tt.wantFound || (found && *got != tt.want) {
+				t.Errorf("GetAlternativeIDs(%q) = (%+v, %v), want (%+v, %v)", tt.gene, got, found, tt.want, tt.wantFound)
+			}
+			if tt.wantString != "" && got.String() != tt.wantString {
+				t.Errorf("String() = %q, want %q", got.String(), tt.wantString)
+			}
+		})
+	}
+}
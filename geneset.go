@@ -0,0 +1,33 @@
+package hgnc_go
+
+// GeneSetToEntrezIDs converts gene symbols (aliases included) to entrez ids, e.g. for GSEA
+// or Enrichr. It returns a map of input to entrez id, and inputs that couldn't be converted.
+func (h *HGNC) GeneSetToEntrezIDs(genes []string) (map[string]string, []string) {
+	return h.geneSetTo(genes, FIELD_ENTREZ_ID)
+}
+
+// GeneSetToEnsgIDs converts gene symbols (aliases included) to ensembl gene ids, e.g. for
+// g:Profiler. It returns a map of input to ensembl gene id, and inputs that couldn't be converted.
+func (h *HGNC) GeneSetToEnsgIDs(genes []string) (map[string]string, []string) {
+	return h.geneSetTo(genes, FIELD_ENSEMBL_GENE_ID)
+}
+
+// geneSetTo converts gene symbols to the target field, inputs without a value are unresolved.
+func (h *HGNC) geneSetTo(genes []string, target Field) (map[string]string, []string) {
+
+	normalized := h.BulkNormalizeSymbols(genes)
+
+	results := make(map[string]string, len(genes))
+	unresolved := make([]string, 0)
+	for _, gene := range genes {
+		symbol, ok := normalized[gene]
+		if ok {
+			if values := h.Lookup(symbol, FIELD_SYMBOL, target); len(values) > 0 && values[0] != "" {
+				results[gene] = values[0]
+				continue
+			}
+		}
+		unresolved = append(unresolved, gene)
+	}
+	return results, unresolved
+}
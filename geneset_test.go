@@ -0,0 +1,72 @@
+package hgnc_go_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestGeneSetTo(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1(),
+		h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("NOIDS").Build())
+
+	genes := []string{"TP53", "p53", " BRCA1 ", "RNF53", "NOIDS", "NOPE", ""}
+	tests := []struct {
+		name           string
+		convert        func([]string) (map[string]string, []string)
+		want           map[string]string
+		wantUnresolved []string
+	}{
+		{
+			"entrez",
+			hgnc.GeneSetToEntrezIDs,
+			map[string]string{"TP53": "7157", "p53": "7157", " BRCA1 ": "672", "RNF53": "672"},
+			[]string{"NOIDS", "NOPE", ""},
+		},
+		{
+			"ensembl",
+			hgnc.GeneSetToEnsgIDs,
+			map[string]string{"TP53": "ENSG00000141510", "p53": "ENSG00000141510", " BRCA1 ": "ENSG00000012048", "RNF53": "ENSG00000012048"},
+			[]string{"NOIDS", "NOPE", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unresolved := tt.convert(genes)
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("converted %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(unresolved, tt.wantUnresolved) {
+				t.Errorf("unresolved %q, want %q", unresolved, tt.wantUnresolved)
+			}
+		})
+	}
+}
+
+func TestBulkNormalizeSymbols(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+
+	tests := []struct {
+		name    string
+		symbols []string
+		want    map[string]string
+	}{
+		{"standard symbols", []string{"TP53", "MIR21"}, map[string]string{"TP53": "TP53", "MIR21": "MIR21"}},
+		{"aliases", []string{"p53", "hsa-mir-21", "BRCC1"}, map[string]string{"p53": "TP53", "hsa-mir-21": "MIR21", "BRCC1": "BRCA1"}},
+		{"surrounding spaces", []string{" TP53\t"}, map[string]string{" TP53\t": "TP53"}},
+		{"unknown symbols are left out", []string{"NOPE", "", "TP53"}, map[string]string{"TP53": "TP53"}},
+		{"empty", nil, map[string]string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hgnc.BulkNormalizeSymbols(tt.symbols); !maps.Equal(got, tt.want) {
+				t.Errorf("BulkNormalizeSymbols(%q) = %v, want %v", tt.symbols, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package hgnc_go
+
+import "strings"
+
+// SymbolGraph is a snapshot of alias / previous symbol relations: each edge goes from an
+// alias or previous symbol to the standard symbol of its record. Chains form when the
+// target is itself replaced, e.g. the symbol of a withdrawn record that became a previous
+// symbol of another record.
+type SymbolGraph struct {
+	edges    map[string]string   // key = alias / previous symbol, value = standard symbol of its record
+	standard map[string]struct{} // standard symbols of all records
+}
+
+// BuildSymbolGraph builds the SymbolGraph of current records.
+// Standard symbols of approved records never get an outgoing edge.
+func (h *HGNC) BuildSymbolGraph() *SymbolGraph {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	g := &SymbolGraph{
+		edges:    make(map[string]string),
+		standard: make(map[string]struct{}, len(h.stdHgncSymbols)),
+	}
+
+	approved := make(map[string]struct{})
+	for _, record := range h.records {
+		sym := strings.TrimSpace(record.data[FIELD_SYMBOL])
+		if sym == "" {
+			continue
+		}
+		g.standard[sym] = struct{}{}
+		if record.IsApproved() {
+			approved[sym] = struct{}{}
+		}
+	}
+
+	for _, record := range h.records {
+		sym := strings.TrimSpace(record.data[FIELD_SYMBOL])
+		if sym == "" {
+			continue
+		}
+		for _, symbols := range [][]string{record.GetAliasSymbols(), record.GetPrevSymbols()} {
+			for _, s := range symbols {
+				if _, ok := approved[s]; ok || s == sym {
+					continue
+				}
+				g.edges[s] = sym
+			}
+		}
+	}
+
+	return g
+}
+
+// Resolve follows edges from symbol to the current standard symbol and reports the number
+// of hops, 0 if symbol is standard already. found is false for unknown symbols and cycles.
+func (g *SymbolGraph) Resolve(symbol string) (standard string, hops int, found bool) {
+
+	symbol = strings.TrimSpace(symbol)
+	visited := make(map[string]struct{})
+	for {
+		next, ok := g.edges[symbol]
+		if !ok {
+			break
+		}
+		if _, ok := visited[symbol]; ok {
+			// cycle
+			return "", hops, false
+		}
+		visited[symbol] = struct{}{}
+		symbol = next
+		hops++
+	}
+
+	if _, ok := g.standard[symbol]; !ok {
+		return "", hops, false
+	}
+	return symbol, hops, true
+}
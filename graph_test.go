@@ -0,0 +1,58 @@
+package hgnc_go_test
+
+import (
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestSymbolGraphResolve(t *testing.T) {
+
+	gene := func(id, symbol string, status h.GeneStatus) *h.RecordBuilder {
+		return h.NewRecordBuilder().WithHgncID(id).WithSymbol(symbol).WithStatus(status)
+	}
+	hgnc := testutil.NewMockHGNC(
+		testutil.FixtureTP53(),
+		// NEW replaced the withdrawn WD, which had replaced ANCIENT
+		gene("HGNC:1", "NEW", h.StatusApproved).WithPrevSymbols("WD").Build(),
+		gene("HGNC:2", "WD", h.StatusWithdrawn).WithPrevSymbols("ANCIENT").Build(),
+		// an approved symbol as alias of another record is not an edge
+		gene("HGNC:3", "OTHER", h.StatusApproved).WithAliasSymbols("TP53", "OTHER").Build(),
+		// withdrawn records replacing each other
+		gene("HGNC:4", "X", h.StatusWithdrawn).WithAliasSymbols("Y").Build(),
+		gene("HGNC:5", "Y", h.StatusWithdrawn).WithAliasSymbols("X").Build(),
+	)
+	g := hgnc.BuildSymbolGraph()
+
+	tests := []struct {
+		symbol    string
+		want      string
+		wantHops  int
+		wantFound bool
+	}{
+		{"TP53", "TP53", 0, true},
+		{" TP53 ", "TP53", 0, true},
+		{"p53", "TP53", 1, true},
+		{"WD", "NEW", 1, true},
+		{"ANCIENT", "NEW", 2, true},
+		{"OTHER", "OTHER", 0, true},
+		{"X", "", 2, false},
+		{"NOPE", "", 0, false},
+		{"", "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.symbol, func(t *testing.T) {
+			got, hops, found := g.Resolve(tt.symbol)
+			if got != tt.want || hops != tt.wantHops || found != tt.wantFound {
+				t.Errorf("Resolve(%q) = (%q, %d, %v), want (%q, %d, %v)", tt.symbol, got, hops, found, tt.want, tt.wantHops, tt.wantFound)
+			}
+		})
+	}
+
+	// a snapshot, later changes don't affect it
+	hgnc.AddRecord(gene("HGNC:6", "LATER", h.StatusApproved).WithAliasSymbols("LATERALIAS").Build())
+	if _, _, found := g.Resolve("LATERALIAS"); found {
+		t.Error("the graph resolved a symbol added after it was built")
+	}
+}
@@ -1,39 +1,204 @@
 package hgnc_go
 
 import (
-	"bufio"
 	"compress/gzip"
-	"errors"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
 	"os"
 	"strings"
+	"sync"
+	"time"
 )
 
 // Cache is a map of field to a slice of integers.
 // Each integer represents a index of HGNC.records.
 type Cache map[string][]int
 
+// HGNC is safe for concurrent use: read methods share mu, write methods hold it exclusively.
 type HGNC struct {
-	records        []*Record           // all records in HGNC file
-	geneSymbolMap  map[string]string   // cache, key = symbol, value = standard HGNC symbol
-	stdHgncSymbols map[string]struct{} // cache, key = standard HGNC symbol, value = empty struct{}
-	caches         map[Field]Cache     // cache for some important fields
-	autoNormSymbol bool                // whether to normalize symbol automatically
+	mu             sync.RWMutex         // protects all fields below
+	records        []*Record            // all records in HGNC file
+	geneSymbolMap  map[string]string    // cache, key = symbol, value = standard HGNC symbol
+	stdHgncSymbols map[string]struct{}  // cache, key = standard HGNC symbol, value = empty struct{}
+	caches         map[Field]Cache      // cache for indexed fields, nil = built on first use (index.go)
+	symbolPriority map[string]string    // alias / previous symbols mapped regardless of records, set by Merge
+	autoNormSymbol bool                 // whether to normalize symbol automatically
+	keepEnsgVer    bool                 // whether ensembl gene ids keep their version suffix, see SetEnsgVersionStripping
+	releaseDate    time.Time            // release date of the loaded HGNC data, zero if unknown
+	sourceURL      string               // where the loaded HGNC data comes from
+	validation     ValidationResult     // header issues found while loading
+	indexOnce      map[Field]*sync.Once // builds lazy caches only once, see EnsureIndex
+	version        uint64               // incremented on every mutation of records
+	ciCaches       map[Field]Cache      // case-insensitive caches, keys are upper-cased (see FetchCI)
+	fileModTime    time.Time            // modification time of the loaded file, zero if not loaded from a file
+	modified       bool                 // records added, removed or updated since loading, see SaveIndex
+	metrics        queryObserver        // receives Fetch / Lookup measurements, nil if not set
+	logger         *slog.Logger         // logger of load and query events, nil for slog.Default()
+	bg             background           // background tasks, stopped by Close
+	loadCfg        *loadConfig          // options the data was loaded with, reused by WatchFile; nil if not parsed from TSV
+
+	// companion data, not part of HGNC records
+	coordinates map[string]*GenomicCoordinates // key = ensembl gene id, set by LoadEnsemblGTF
+}
+
+// log returns the logger of h.
+func (h *HGNC) log() *slog.Logger {
+	if h.logger != nil {
+		return h.logger
+	}
+	return slog.Default()
 }
 
 func (h *HGNC) SetAutoNormSymbol(autoNormSymbol bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
 	h.autoNormSymbol = autoNormSymbol
 }
 
+// SetEnsgVersionStripping sets whether ensembl gene ids given to converters (e.g. EnsgToSymbol)
+// are stripped of their version suffix ("ENSG00000141510.18" -> "ENSG00000141510"), enabled by default.
+// When disabled, ids are matched as given, so only bare ids match the index.
+func (h *HGNC) SetEnsgVersionStripping(enabled bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.keepEnsgVer = !enabled
+}
+
+// ensgKey prepares an ensembl gene id for lookup, following SetEnsgVersionStripping.
+func (h *HGNC) ensgKey(ensg string) string {
+	if h == nil {
+		panic("HGNC is nil")
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.keepEnsgVer {
+		return ensg
+	}
+	return stripVersion(ensg)
+}
+
+// utf8BOM is the byte order mark some editors prepend to UTF-8 files.
+const utf8BOM = "\xEF\xBB\xBF"
+
 // LoadTsv is the constructor of HGNC struct.
-func LoadTsv(filepath string, gzipped bool) (*HGNC, error) {
+func LoadTsv(filepath string, gzipped bool, opts ...LoadOption) (*HGNC, error) {
+	return loadTsv(filepath, gzipped, newLoadConfig(opts))
+}
+
+// loadTsv is the implementation of LoadTsv with an assembled config.
+func loadTsv(filepath string, gzipped bool, cfg *loadConfig) (*HGNC, error) {
+
+	// open file
+	fh, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+	info, err := fh.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.checksum != nil {
+		if err := cfg.checksum.verifyFile(fh); err != nil {
+			return nil, err
+		}
+	}
+
+	var h *HGNC
+	if !gzipped {
+		h, err = load(fh, cfg, true)
+	} else {
+		gz, gzErr := gzip.NewReader(fh)
+		if gzErr != nil {
+			return nil, gzErr
+		}
+		defer gz.Close()
+		// size of decompressed data is unknown
+		h, err = load(gz, cfg, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	h.fileModTime = info.ModTime()
+
+	// release date from file name, unless found in a comment line
+	if h.releaseDate.IsZero() {
+		if releaseDate, ok := detectReleaseDateFromPath(filepath); ok {
+			h.releaseDate = releaseDate
+		}
+	}
+
+	return h, nil
+}
+
+// LoadFromCSV is like LoadTsv, but for comma-separated files with RFC 4180 quoting.
+// A WithDelimiter option in opts takes precedence over the comma.
+func LoadFromCSV(filepath string, gzipped bool, opts ...LoadOption) (*HGNC, error) {
+	return LoadTsv(filepath, gzipped, append([]LoadOption{WithDelimiter(',')}, opts...)...)
+}
+
+// LoadFromReader is like LoadTsv, but reads uncompressed data from r.
+func LoadFromReader(r io.Reader, opts ...LoadOption) (*HGNC, error) {
+
+	cfg := newLoadConfig(opts)
+	if cfg.checksum == nil {
+		return load(r, cfg, true)
+	}
+
+	// r can't be rewound, so the checksum is verified after parsing
+	hash, err := cfg.checksum.newHash()
+	if err != nil {
+		return nil, err
+	}
+	tee := io.TeeReader(r, hash)
+	h, err := load(tee, cfg, true)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(io.Discard, tee); err != nil {
+		return nil, err
+	}
+	if err := cfg.checksum.verify(hash); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// LoadJSON is like LoadFromReader, but reads the JSON bulk download of HGNC
+// ({"response": {"docs": [...]}}). Arrays are joined with "|" as in the TSV file.
+func LoadJSON(r io.Reader) (*HGNC, error) {
+
+	var payload restResponse
+	if err := json.NewDecoder(r).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("failed decoding HGNC JSON: %w", err)
+	}
+
+	h := newHGNC()
+	h.records = make([]*Record, 0, len(payload.Response.Docs))
+	for _, doc := range payload.Response.Docs {
+		h.records = append(h.records, jsonDoc2Record(doc))
+	}
+
+	// symbol maps & caches
+	h.RebuildIndexes()
+
+	return h, nil
+}
+
+// newHGNC creates an empty HGNC struct with default settings and caches.
+func newHGNC() *HGNC {
 
-	// init
 	h := &HGNC{
 		records:        make([]*Record, 0),
 		geneSymbolMap:  make(map[string]string),
 		stdHgncSymbols: make(map[string]struct{}),
 		caches:         make(map[Field]Cache),
 		autoNormSymbol: true,
+		indexOnce:      make(map[Field]*sync.Once),
+		ciCaches:       make(map[Field]Cache),
 	}
 
 	for _, field := range indexedFields {
@@ -43,119 +208,202 @@ func LoadTsv(filepath string, gzipped bool) (*HGNC, error) {
 		cache := make(Cache)
 		h.caches[field] = cache
 	}
+	// other fields are indexed on first use
+	h.registerLazyIndexes()
 
-	// open file
-	fh, err := os.Open(filepath)
+	return h
+}
+
+// load parses TSV data from r and builds the HGNC struct.
+// sizeKnown tells whether bytes consumed can be reported to the progress callback.
+func load(r io.Reader, cfg *loadConfig, sizeKnown bool) (*HGNC, error) {
+
+	h := newHGNC()
+	h.metrics = cfg.metrics
+	h.logger = cfg.logger
+	h.keepEnsgVer = !cfg.ensgVersionStripping
+	reload := *cfg
+	reload.skipIndex = false // indexes restored from an index file are not valid after a change
+	reload.validationOut = nil
+	reload.checksum = nil // the digest is of the file as loaded, a changed file never matches it
+	h.loadCfg = &reload
+	h.log().Info("hgnc: loading")
+
+	table, err := openTable(r, cfg.delimiter)
 	if err != nil {
 		return nil, err
 	}
-	defer fh.Close()
+	h.releaseDate = table.releaseDate
+	rows, headerMap := table.rows, table.headerMap
+	var linesRead int64
 
-	var scanner *bufio.Scanner
+	// validate header
+	validation := validateHeader(headerMap)
+	if len(validation.UnknownColumns) > 0 {
+		h.log().Warn("hgnc: unknown columns", "columns", validation.UnknownColumns)
+	}
+	if cfg.validationOut != nil {
+		*cfg.validationOut = validation
+	}
+	if cfg.validate {
+		h.validation = validation
+		if cfg.strict {
+			if err := h.validation.error(); err != nil {
+				return nil, err
+			}
+		}
+	}
 
-	if !gzipped {
-		scanner = bufio.NewScanner(fh)
-	} else {
-		gz, err := gzip.NewReader(fh)
+	// collect data
+	var pool map[string]string
+	if cfg.intern {
+		pool = make(map[string]string)
+	}
+	for {
+		values, err := rows.read()
+		if err == io.EOF {
+			break
+		}
 		if err != nil {
 			return nil, err
 		}
-		scanner = bufio.NewScanner(gz)
-		defer gz.Close()
+		if len(values) != len(headerMap) {
+			h.log().Error("hgnc: row does not match the header, missing values are left empty",
+				"row", linesRead+1, "values", len(values), "columns", len(headerMap))
+		}
+		record := fields2Record(values, headerMap, pool)
+
+		h.records = append(h.records, record)
+
+		// progress
+		linesRead++
+		if cfg.progress != nil && linesRead%progressInterval == 0 {
+			cfg.reportProgress(linesRead, table.offset(), sizeKnown)
+		}
+	}
+	if cfg.progress != nil && linesRead%progressInterval != 0 {
+		cfg.reportProgress(linesRead, table.offset(), sizeKnown)
 	}
 
-	// read header line
-	if !scanner.Scan() {
-		if serr := scanner.Err(); serr != nil {
-			return nil, serr
+	// case-insensitive caches of indexed fields, filled by RebuildIndexes
+	if cfg.caseInsensitive {
+		for _, field := range indexedFields {
+			h.ciCaches[field] = make(Cache)
 		}
-		return nil, errors.New("failed reading header line")
 	}
-	headerLine := scanner.Text()
-	headerMap := make(map[string]int)
-	for i, field := range strings.Split(headerLine, "\t") {
-		f := strings.TrimSpace(field)
-		f = strings.Trim(f, "\"")
-		headerMap[f] = i
+
+	// symbol maps & caches, unless restored from an index file
+	if !cfg.skipIndex {
+		h.RebuildIndexes()
 	}
 
-	// collect data
-	recordIdx := 0
-	for scanner.Scan() {
-		line := scanner.Text()
-		record := line2Record(line, headerMap)
+	h.log().Info("hgnc: loaded", "records", len(h.records))
+	return h, nil
+}
 
-		// records
-		h.records = append(h.records, record)
+// RebuildIndexes clears and rebuilds all built caches (fields in h.caches),
+// as well as the standard / alias / previous symbol maps, from h.records.
+// It is the safe fallback after any mutation of records.
+func (h *HGNC) RebuildIndexes() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.rebuildIndexes()
+}
 
-		// standard symbols
-		sym := strings.TrimSpace(record.data[FIELD_SYMBOL])
-		if sym != "" {
-			h.stdHgncSymbols[sym] = struct{}{}
-		}
+// rebuildIndexes is the lock-free implementation of RebuildIndexes.
+func (h *HGNC) rebuildIndexes() {
 
-		// alias & prev symbols
-		aliasSymbolStr := record.data[FIELD_ALIAS_SYMBOL]
-		prevSymbolStr := record.data[FIELD_PREV_SYMBOL]
-		if sym != "" && aliasSymbolStr != "" {
-			for _, alias := range strings.Split(aliasSymbolStr, "|") {
-				alias = strings.TrimSpace(alias)
-				if alias != "" {
-					h.geneSymbolMap[alias] = sym
-				}
-			}
+	h.geneSymbolMap = make(map[string]string)
+	h.stdHgncSymbols = make(map[string]struct{})
+	for field, cache := range h.caches {
+		if cache != nil {
+			h.caches[field] = make(Cache)
+		} else {
+			// lazy, EnsureIndex must be able to build it
+			delete(h.indexOnce, field)
 		}
-		if sym != "" && prevSymbolStr != "" {
-			for _, prevSymbol := range strings.Split(prevSymbolStr, "|") {
-				prevSymbol = strings.TrimSpace(prevSymbol)
-				if prevSymbol != "" {
-					h.geneSymbolMap[prevSymbol] = sym
-				}
-			}
+	}
+	for field := range h.ciCaches {
+		h.ciCaches[field] = make(Cache)
+	}
+	h.version++
+
+	for recordIdx, record := range h.records {
+		h.indexRecord(recordIdx, record)
+	}
+	for symbol, stdSymbol := range h.symbolPriority {
+		if h.hasSymbolPriority(symbol) {
+			h.geneSymbolMap[symbol] = stdSymbol
 		}
+	}
+}
 
-		// caches
-		for _, field := range indexedFields {
-			value := record.data[field]
-			// h.caches -> map[Field]Cache
-			// h.caches[field] -> cache -> map[string][]int
-			// h.caches[field][value] -> []int
-			if value == "" {
-				continue
+// hasSymbolPriority tells whether symbol is mapped by h.symbolPriority instead of records,
+// which holds while its standard symbol has a record.
+func (h *HGNC) hasSymbolPriority(symbol string) bool {
+	stdSymbol, ok := h.symbolPriority[symbol]
+	if !ok {
+		return false
+	}
+	_, ok = h.stdHgncSymbols[stdSymbol]
+	return ok
+}
+
+// indexRecord adds a record (at index recordIdx of h.records) to symbol maps and caches.
+func (h *HGNC) indexRecord(recordIdx int, record *Record) {
+
+	// standard symbols
+	sym := strings.TrimSpace(record.data[FIELD_SYMBOL])
+	if sym != "" {
+		h.stdHgncSymbols[sym] = struct{}{}
+	}
+
+	// alias & prev symbols
+	if sym != "" {
+		for _, alias := range record.GetAliasSymbols() {
+			if !h.hasSymbolPriority(alias) {
+				h.geneSymbolMap[alias] = sym
 			}
-			if _, ok := h.caches[field][value]; !ok {
-				h.caches[field][value] = []int{recordIdx}
-			} else {
-				h.caches[field][value] = append(
-					h.caches[field][value], recordIdx,
-				)
+		}
+		for _, prevSymbol := range record.GetPrevSymbols() {
+			if !h.hasSymbolPriority(prevSymbol) {
+				h.geneSymbolMap[prevSymbol] = sym
 			}
 		}
-
-		recordIdx++
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
+	// caches
+	for field, cache := range h.caches {
+		if cache == nil {
+			// lazy, not built yet
+			continue
+		}
+		// h.caches -> map[Field]Cache
+		// h.caches[field] -> cache -> map[string][]int
+		// h.caches[field][value] -> []int
+		cache.add(field, record.data[field], recordIdx)
+	}
+	for field, cache := range h.ciCaches {
+		cache.add(field, strings.ToUpper(record.data[field]), recordIdx)
 	}
-
-	return h, nil
 }
 
-// line2Record converts a line of HGNC file to a Record struct.
-func line2Record(line string, headerMap map[string]int) *Record {
+// fields2Record converts values of a line of HGNC file to a Record struct.
+// If pool is not nil, values are interned: equal values share one string.
+func fields2Record(l []string, headerMap map[string]int, pool map[string]string) *Record {
 
 	record := new(Record)
 	record.data = make(map[Field]string)
 
-	l := strings.Split(line, "\t")
-
 	for fieldName, tsvIdx := range headerMap {
 		if tsvIdx < len(l) {
 			// !!! some fields are quoted with double quotes,
 			// or with spaces at the beginning or end.
 			value := strings.Trim(l[tsvIdx], "\"")
 			value = strings.TrimSpace(value)
+			if pool != nil {
+				value = intern(pool, value)
+			}
 			record.data[Field(fieldName)] = value
 		} else {
 			record.data[Field(fieldName)] = ""
@@ -164,3 +412,14 @@ func line2Record(line string, headerMap map[string]int) *Record {
 
 	return record
 }
+
+// intern returns the pooled copy of s, adding s to the pool if absent.
+func intern(pool map[string]string, s string) string {
+	if interned, ok := pool[s]; ok {
+		return interned
+	}
+	// clone, so the pool doesn't keep the whole source line alive
+	s = strings.Clone(s)
+	pool[s] = s
+	return s
+}
@@ -0,0 +1,121 @@
+package hgnc_go_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestRebuildIndexes(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		prepare func(hgnc *h.HGNC)
+		indexed []h.Field // fields that must stay indexed
+		mir21   []string  // Fetch("MIR21") result
+	}{
+		{"fresh", func(*h.HGNC) {}, []h.Field{h.FIELD_SYMBOL, h.FIELD_ENTREZ_ID}, []string{}},
+		{"after AddRecord", func(hgnc *h.HGNC) {
+			hgnc.AddRecord(testutil.FixtureMIR21())
+		}, nil, []string{"MIR21"}},
+		{"after RemoveRecord", func(hgnc *h.HGNC) {
+			hgnc.AddRecord(testutil.FixtureMIR21())
+			hgnc.RemoveRecord("HGNC:31586")
+		}, nil, []string{}},
+		{"with an added index", func(hgnc *h.HGNC) {
+			hgnc.AddIndex(h.FIELD_LOCATION)
+		}, []h.Field{h.FIELD_LOCATION}, []string{}},
+		{"with a removed index", func(hgnc *h.HGNC) {
+			hgnc.RemoveIndex(h.FIELD_ENTREZ_ID)
+		}, nil, []string{}},
+		{"with a case-insensitive index", func(hgnc *h.HGNC) {
+			hgnc.FetchCI("tp53", h.FIELD_SYMBOL)
+		}, nil, []string{}},
+	}
+	queries := []struct {
+		value string
+		field h.Field
+		ci    bool
+		want  []string
+	}{
+		{"TP53", h.FIELD_SYMBOL, false, []string{"TP53"}},
+		{"p53", h.FIELD_SYMBOL, false, []string{"TP53"}},
+		{"672", h.FIELD_ENTREZ_ID, false, []string{"BRCA1"}},
+		{"17q21.31", h.FIELD_LOCATION, false, []string{"BRCA1"}},
+		{"brca1", h.FIELD_SYMBOL, true, []string{"BRCA1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1())
+			tt.prepare(hgnc)
+			hgnc.RebuildIndexes()
+
+			for _, field := range tt.indexed {
+				if !field.IsIndexed(hgnc) {
+					t.Errorf("%s is no longer indexed", field)
+				}
+			}
+			for _, q := range queries {
+				records := hgnc.Fetch(q.value, q.field)
+				if q.ci {
+					records = hgnc.FetchCI(q.value, q.field)
+				}
+				if got := symbols(records); !slices.Equal(got, q.want) {
+					t.Errorf("Fetch(%q, %s) = %q, want %q", q.value, q.field, got, q.want)
+				}
+			}
+			if got := symbols(hgnc.Fetch("MIR21", h.FIELD_SYMBOL)); !slices.Equal(got, tt.mir21) {
+				t.Errorf("Fetch(MIR21) = %q, want %q", got, tt.mir21)
+			}
+		})
+	}
+}
+
+func TestLoadJSON(t *testing.T) {
+
+	const bulk = `{"responseHeader": {"status": 0}, "response": {"numFound": 2, "docs": [
+		{"hgnc_id": "HGNC:11998", "symbol": "TP53", "entrez_id": "7157", "alias_symbol": ["p53", "LFS1"],
+		 "gene_group": ["Tumor suppressors"], "gene_group_id": [1234], "omim_id": ["191170"], "_version_": 1},
+		{"hgnc_id": "HGNC:1100", "symbol": "BRCA1", "entrez_id": 672, "prev_symbol": [" BRCC1X "]}
+	]}}`
+
+	hgnc, err := h.LoadJSON(strings.NewReader(bulk))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := hgnc.RecordCount(); got != 2 {
+		t.Fatalf("loaded %d records, want 2", got)
+	}
+
+	tests := []struct {
+		value  string
+		query  h.Field
+		target h.Field
+		want   []string
+	}{
+		{"TP53", h.FIELD_SYMBOL, h.FIELD_ALIAS_SYMBOL, []string{"p53|LFS1"}},
+		{"p53", h.FIELD_SYMBOL, h.FIELD_HGNC_ID, []string{"HGNC:11998"}},
+		{"TP53", h.FIELD_SYMBOL, h.FIELD_GENE_FAMILY, []string{"Tumor suppressors"}},
+		{"TP53", h.FIELD_SYMBOL, h.FIELD_GENE_FAMILY_ID, []string{"1234"}},
+		{"191170", h.FIELD_OMIM_ID, h.FIELD_SYMBOL, []string{"TP53"}},
+		{"672", h.FIELD_ENTREZ_ID, h.FIELD_SYMBOL, []string{"BRCA1"}},
+		{"BRCC1X", h.FIELD_SYMBOL, h.FIELD_SYMBOL, []string{"BRCA1"}},
+		{"BRCA1", h.FIELD_SYMBOL, h.FIELD_NAME, []string{""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value+" "+string(tt.target), func(t *testing.T) {
+			if got := hgnc.Lookup(tt.value, tt.query, tt.target); !slices.Equal(got, tt.want) {
+				t.Errorf("Lookup(%q, %s, %s) = %q, want %q", tt.value, tt.query, tt.target, got, tt.want)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"", "[]", `{"response": {"docs": {}}}`} {
+		if _, err := h.LoadJSON(strings.NewReader(invalid)); err == nil {
+			t.Errorf("LoadJSON(%q) returned no error", invalid)
+		}
+	}
+}
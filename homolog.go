@@ -0,0 +1,31 @@
+package hgnc_go
+
+// HomologInfo holds mouse and rat homologs of a human gene.
+type HomologInfo struct {
+	MouseMgdIDs []string // e.g. "MGI:98834"
+	RatRgdIDs   []string // e.g. "RGD:3889"
+}
+
+// GetHomologs gets mouse (MGD) and rat (RGD) ids of a gene
+func (h *HGNC) GetHomologs(gene string) (*HomologInfo, bool) {
+	gene, field := h.classifyGene(gene)
+	if records := h.Fetch(gene, field); len(records) > 0 {
+		return &HomologInfo{
+			MouseMgdIDs: SplitMultiValue(records[0].data[FIELD_MGD_ID]),
+			RatRgdIDs:   SplitMultiValue(records[0].data[FIELD_RGD_ID]),
+		}, true
+	}
+	return nil, false
+}
+
+// HasMouseHomolog checks if a gene has at least one MGD id
+func (h *HGNC) HasMouseHomolog(gene string) bool {
+	info, found := h.GetHomologs(gene)
+	return found && len(info.MouseMgdIDs) > 0
+}
+
+// HasRatHomolog checks if a gene has at least one RGD id
+func (h *HGNC) HasRatHomolog(gene string) bool {
+	info, found := h.GetHomologs(gene)
+	return found && len(info.RatRgdIDs) > 0
+}
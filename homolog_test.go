@@ -0,0 +1,56 @@
+package hgnc_go_test
+
+import (
+	"slices"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestGetHomologs(t *testing.T) {
+
+	several := h.NewRecordBuilder().WithHgncID("HGNC:5").WithSymbol("SEVERAL").
+		With(h.FIELD_MGD_ID, "MGI:1|MGI:2").Build()
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureMIR21(), several)
+
+	tests := []struct {
+		gene      string
+		wantFound bool
+		wantMouse []string
+		wantRat   []string
+	}{
+		{"TP53", true, []string{"MGI:98834"}, []string{"RGD:3889"}},
+		{"HGNC:11998", true, []string{"MGI:98834"}, []string{"RGD:3889"}},
+		{"7157", true, []string{"MGI:98834"}, []string{"RGD:3889"}},
+		{"SEVERAL", true, []string{"MGI:1", "MGI:2"}, nil},
+		{"MIR21", true, nil, nil},
+		{"NOPE", false, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.gene, func(t *testing.T) {
+			info, found := hgnc.GetHomologs(tt.gene)
+			if found != tt.wantFound {
+				t.Fatalf("GetHomologs(%q) found = %v, want %v", tt.gene, found, tt.wantFound)
+			}
+			if !found {
+				if info != nil {
+					t.Errorf("GetHomologs(%q) = %+v for a gene not found", tt.gene, info)
+				}
+				return
+			}
+			if !slices.Equal(info.MouseMgdIDs, tt.wantMouse) {
+				t.Errorf("mouse = %q, want %q", info.MouseMgdIDs, tt.wantMouse)
+			}
+			if !slices.Equal(info.RatRgdIDs, tt.wantRat) {
+				t.Errorf("rat = %q, want %q", info.RatRgdIDs, tt.wantRat)
+			}
+			if got := hgnc.HasMouseHomolog(tt.gene); got != (len(tt.wantMouse) > 0) {
+				t.Errorf("HasMouseHomolog(%q) = %v", tt.gene, got)
+			}
+			if got := hgnc.HasRatHomolog(tt.gene); got != (len(tt.wantRat) > 0) {
+				t.Errorf("HasRatHomolog(%q) = %v", tt.gene, got)
+			}
+		})
+	}
+}
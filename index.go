@@ -0,0 +1,186 @@
+package hgnc_go
+
+import (
+	"strings"
+	"sync"
+)
+
+/*
+Lazy indexes
+
+Every known field is registered in h.caches when loading. Fields in indexedFields
+get their cache built right away, the others are registered with a nil Cache and
+built on first use by EnsureIndex (called by Fetch and Lookup), guarded by a
+per-field sync.Once so concurrent callers build it only once.
+
+	h.caches[field] absent  -> not indexed, linear scan
+	h.caches[field] == nil  -> registered, built on first use
+	h.caches[field] != nil  -> indexed
+
+Fields in tokenIndexedFields are multi-value fields indexed by each value split
+on "|", so a single accession finds the record; the others are indexed by the
+whole field value.
+
+h.ciCaches holds upper-cased caches for FetchCI, only for indexed fields. They are
+kept up to date by RebuildIndexes and AddRecord once built.
+*/
+
+// EnsureIndex builds the cache of a registered field if it is not built yet.
+// Concurrent calls for the same field build it only once. Readers are only
+// blocked while the built cache is swapped in.
+func (h *HGNC) EnsureIndex(field Field) {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	// fast path, readers don't block each other once the cache is built
+	h.mu.RLock()
+	cache, ok := h.caches[field]
+	h.mu.RUnlock()
+	if !ok || cache != nil {
+		return
+	}
+
+	h.mu.Lock()
+	if cache, ok := h.caches[field]; !ok || cache != nil {
+		h.mu.Unlock()
+		return
+	}
+	once, ok := h.indexOnce[field]
+	if !ok {
+		once = new(sync.Once)
+		h.indexOnce[field] = once
+	}
+	h.mu.Unlock()
+
+	once.Do(func() {
+		h.mu.RLock()
+		version := h.version
+		cache := buildCache(h.records, field)
+		h.mu.RUnlock()
+
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if c, ok := h.caches[field]; !ok || c != nil {
+			// removed, or built by AddIndex meanwhile
+			return
+		}
+		if h.version != version {
+			// records changed while building
+			cache = buildCache(h.records, field)
+		}
+		h.caches[field] = cache
+	})
+}
+
+// ensureLazyIndex calls EnsureIndex if the field is registered but not built yet.
+func (h *HGNC) ensureLazyIndex(field Field) {
+	h.mu.RLock()
+	cache, ok := h.caches[field]
+	h.mu.RUnlock()
+	if ok && cache == nil {
+		h.EnsureIndex(field)
+	}
+}
+
+// registerLazyIndexes registers a nil Cache for every known field without a cache.
+// A sync.Once left from a previous registration is dropped, so EnsureIndex builds it again.
+func (h *HGNC) registerLazyIndexes() {
+	for field := range fieldDesc {
+		if _, ok := h.caches[field]; !ok {
+			h.caches[field] = nil
+			delete(h.indexOnce, field)
+		}
+	}
+}
+
+// ensureCIIndex builds the case-insensitive cache of a field if the field is indexed
+// (or registered as lazy index) and its case-insensitive cache is not built yet.
+func (h *HGNC) ensureCIIndex(field Field) {
+
+	h.mu.RLock()
+	_, indexed := h.caches[field]
+	_, built := h.ciCaches[field]
+	h.mu.RUnlock()
+	if !indexed || built {
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.ciCaches[field]; ok {
+		return
+	}
+	cache := make(Cache)
+	for recordIdx, record := range h.records {
+		cache.add(field, strings.ToUpper(record.data[field]), recordIdx)
+	}
+	h.ciCaches[field] = cache
+}
+
+// tokenIndexedFields are multi-value fields indexed per value instead of the whole field.
+var tokenIndexedFields = map[Field]struct{}{
+	FIELD_OMIM_ID:          {},
+	FIELD_UNIPROT_IDS:      {},
+	FIELD_ENA:              {},
+	FIELD_CCDS_ID:          {},
+	FIELD_REFSEQ_ACCESSION: {},
+}
+
+// versionlessFields are token indexed fields whose tokens are indexed without version suffix,
+// e.g. "CCDS11118.1" is indexed as "CCDS11118".
+var versionlessFields = map[Field]struct{}{
+	FIELD_CCDS_ID: {},
+}
+
+// buildCache indexes records by value of the field.
+func buildCache(records []*Record, field Field) Cache {
+	cache := make(Cache)
+	for recordIdx, record := range records {
+		cache.add(field, record.data[field], recordIdx)
+	}
+	return cache
+}
+
+// add indexes the field value of the record at recordIdx, per token for tokenIndexedFields.
+func (c Cache) add(field Field, value string, recordIdx int) {
+	for _, key := range cacheKeys(field, value) {
+		if n := len(c[key]); n > 0 && c[key][n-1] == recordIdx {
+			// repeated token in the same record
+			continue
+		}
+		c[key] = append(c[key], recordIdx)
+	}
+}
+
+// cacheKeys returns the keys a field value is indexed by.
+func cacheKeys(field Field, value string) []string {
+	if value == "" {
+		return nil
+	}
+	if _, ok := tokenIndexedFields[field]; ok {
+		tokens := SplitMultiValue(value)
+		if _, ok := versionlessFields[field]; ok {
+			for i, token := range tokens {
+				tokens[i] = stripVersion(token)
+			}
+		}
+		return tokens
+	}
+	return []string{value}
+}
+
+// matchValue tells whether the field of the record matches value the same way as its cache,
+// it is used when the field is not indexed.
+func matchValue(record *Record, field Field, value string) bool {
+	if _, ok := tokenIndexedFields[field]; !ok {
+		return record.data[field] == value
+	}
+	for _, token := range cacheKeys(field, record.data[field]) {
+		if token == value {
+			return true
+		}
+	}
+	return false
+}
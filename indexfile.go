@@ -0,0 +1,174 @@
+package hgnc_go
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+/*
+Index file written by SaveIndex():
+
+	magic (6 bytes, "HGNCIX") | version (1 byte) | gob encoded savedIndex
+
+It holds only indexes (caches and symbol maps), records are parsed from the TSV
+file again by LoadTsvWithIndex. Cached record positions are only valid for the
+same file, so the modification time and the record count of the TSV file are
+stored too, and a mismatch makes LoadTsvWithIndex rebuild the indexes.
+*/
+
+var savedIndexMagic = []byte("HGNCIX")
+
+// savedIndexVersion is bumped when cache keys change, as for savedVersion.
+//
+//	1: initial format
+//	2: multi-value fields are cached per value, CCDS IDs without version suffix
+const savedIndexVersion byte = 2
+
+// savedIndex is the serializable representation of HGNC indexes.
+type savedIndex struct {
+	FileModTime    time.Time
+	RecordCount    int
+	Caches         map[Field]Cache
+	GeneSymbolMap  map[string]string
+	StdHgncSymbols []string
+}
+
+// SaveIndex writes built indexes (not records) to path, so LoadTsvWithIndex can skip
+// building them. The database must be loaded by LoadTsv from an unmodified file, and
+// records must not be added, removed or updated since.
+func (h *HGNC) SaveIndex(path string) error {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if h.fileModTime.IsZero() {
+		return errors.New("HGNC is not loaded from a file, indexes can't be matched to it")
+	}
+	if h.modified {
+		return errors.New("HGNC records were modified since loading, indexes don't match the file")
+	}
+
+	s := savedIndex{
+		FileModTime:    h.fileModTime,
+		RecordCount:    len(h.records),
+		Caches:         make(map[Field]Cache, len(h.caches)),
+		GeneSymbolMap:  h.geneSymbolMap,
+		StdHgncSymbols: make([]string, 0, len(h.stdHgncSymbols)),
+	}
+	for field, cache := range h.caches {
+		if cache != nil {
+			s.Caches[field] = cache
+		}
+	}
+	for sym := range h.stdHgncSymbols {
+		s.StdHgncSymbols = append(s.StdHgncSymbols, sym)
+	}
+
+	fh, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(fh)
+	bw.Write(savedIndexMagic)
+	bw.WriteByte(savedIndexVersion)
+	if err := gob.NewEncoder(bw).Encode(&s); err != nil {
+		fh.Close()
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		fh.Close()
+		return err
+	}
+	return fh.Close()
+}
+
+// LoadTsvWithIndex is like LoadTsv, but restores indexes from an index file written by
+// SaveIndex instead of building them. If the index file is missing, unreadable or stale
+// (the TSV file changed since), indexes are rebuilt as LoadTsv does.
+func LoadTsvWithIndex(tsvPath, indexPath string, gzipped bool, opts ...LoadOption) (*HGNC, error) {
+
+	cfg := newLoadConfig(opts)
+	index, err := readIndexFile(indexPath)
+	if err != nil {
+		cfg.log().Warn("hgnc: can't use index file, building indexes", "path", indexPath, "error", err)
+		return loadTsv(tsvPath, gzipped, cfg)
+	}
+
+	cfg.skipIndex = true
+	h, err := loadTsv(tsvPath, gzipped, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !h.fileModTime.Equal(index.FileModTime) || len(h.records) != index.RecordCount {
+		h.log().Info("hgnc: index file is stale, building indexes", "path", indexPath)
+		h.RebuildIndexes()
+		return h, nil
+	}
+
+	h.caches = index.Caches
+	if h.caches == nil {
+		h.caches = make(map[Field]Cache)
+	}
+	for field, cache := range h.caches {
+		if cache == nil {
+			h.caches[field] = make(Cache)
+		}
+	}
+	h.registerLazyIndexes()
+	if index.GeneSymbolMap != nil {
+		h.geneSymbolMap = index.GeneSymbolMap
+	}
+	for _, sym := range index.StdHgncSymbols {
+		h.stdHgncSymbols[sym] = struct{}{}
+	}
+	h.version++
+
+	// case-insensitive caches are not saved, build them if requested
+	if cfg.caseInsensitive {
+		h.ciCaches = make(map[Field]Cache)
+		for _, field := range indexedFields {
+			h.ensureCIIndex(field)
+		}
+	}
+
+	return h, nil
+}
+
+// readIndexFile reads and checks an index file written by SaveIndex.
+func readIndexFile(path string) (*savedIndex, error) {
+
+	fh, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fh.Close()
+	r := bufio.NewReader(fh)
+
+	header := make([]byte, len(savedIndexMagic)+1)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed reading index file header: %w", err)
+	}
+	if !bytes.Equal(header[:len(savedIndexMagic)], savedIndexMagic) {
+		return nil, errors.New("not an HGNC index file (bad magic number)")
+	}
+	if version := header[len(savedIndexMagic)]; version != savedIndexVersion {
+		return nil, fmt.Errorf("incompatible index file version %d (expected %d)", version, savedIndexVersion)
+	}
+
+	var s savedIndex
+	if err := gob.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed decoding index file: %w", err)
+	}
+	return &s, nil
+}
@@ -0,0 +1,96 @@
+package hgnc_go
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/*
+Cytogenetic locations (FIELD_LOCATION) look like:
+
+	17q21.31               chromosome 17, arm q, band 21.31
+	Xp22.33 and Yp11.2     pseudoautosomal genes, the first location is used
+	1p36.33-p36.32         a range of bands
+	17                     chromosome only
+	mitochondria           mitochondrial genes
+	reserved, not on reference assembly, ...
+*/
+
+// locationPattern captures the chromosome and arm at the start of a location.
+var locationPattern = regexp.MustCompile(`^(\d{1,2}|X|Y)([pq]?)`)
+
+// ExtractChromosome returns the chromosome of a cytogenetic location:
+// "1" to "22", "X", "Y", "MT", or "" if the location can't be parsed.
+func ExtractChromosome(location string) string {
+	chromosome, _ := parseLocation(location)
+	return chromosome
+}
+
+// ExtractChromosomeArm returns the arm of a cytogenetic location, "p", "q",
+// or "" if there is no arm (e.g. "17", "mitochondria").
+func ExtractChromosomeArm(location string) string {
+	_, arm := parseLocation(location)
+	return arm
+}
+
+// parseLocation splits the start of a location into chromosome and arm.
+func parseLocation(location string) (chromosome, arm string) {
+
+	location = strings.TrimSpace(location)
+	if strings.HasPrefix(strings.ToLower(location), "mitochondria") {
+		return "MT", ""
+	}
+
+	m := locationPattern.FindStringSubmatch(location)
+	if m == nil {
+		return "", ""
+	}
+	if n, err := strconv.Atoi(m[1]); err == nil && (n < 1 || n > 22) {
+		return "", ""
+	}
+	// the chromosome must not be followed by other digits or letters, e.g. "123"
+	if rest := location[len(m[0]):]; m[2] == "" && rest != "" && !strings.HasPrefix(rest, " ") && !strings.HasPrefix(rest, "cen") {
+		return "", ""
+	}
+	return m[1], m[2]
+}
+
+// GetChromosome returns the chromosome of the Record from its location, see ExtractChromosome.
+func (r *Record) GetChromosome() string {
+	return ExtractChromosome(r.data[FIELD_LOCATION])
+}
+
+// GetChromosomeArm returns the chromosome arm of the Record from its location, see ExtractChromosomeArm.
+func (r *Record) GetChromosomeArm() string {
+	return ExtractChromosomeArm(r.data[FIELD_LOCATION])
+}
+
+// FetchByChromosomeArm retrieves records located on an arm of a chromosome, e.g. ("17", "q").
+func (h *HGNC) FetchByChromosomeArm(chr, arm string) []*Record {
+
+	chr = strings.TrimSpace(chr)
+	arm = strings.TrimSpace(arm)
+	if chr == "" || arm == "" {
+		return make([]*Record, 0)
+	}
+
+	return h.Filter(func(r *Record) bool {
+		c, a := parseLocation(r.data[FIELD_LOCATION])
+		return c == chr && a == arm
+	})
+}
+
+// FetchByLocationPrefix retrieves records whose sortable location starts with prefix,
+// e.g. "17p" for an arm or "17p13" for a band. Note that "1" matches chromosomes 10-19 too.
+func (h *HGNC) FetchByLocationPrefix(prefix string) []*Record {
+
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return make([]*Record, 0)
+	}
+
+	return h.Filter(func(r *Record) bool {
+		return strings.HasPrefix(r.data[FIELD_LOCATION_SORTABLE], prefix)
+	})
+}
@@ -0,0 +1,91 @@
+package hgnc_go_test
+
+import (
+	"slices"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestExtractChromosome(t *testing.T) {
+
+	tests := []struct {
+		location string
+		want     string
+	}{
+		{"17q21.31", "17"},
+		{"17p13.1", "17"},
+		{"1p36.33-p36.32", "1"},
+		{"Xp22.33 and Yp11.2", "X"},
+		{"Yq11.223", "Y"},
+		{"17", "17"},
+		{"22q11.21", "22"},
+		{" 9q34.3 ", "9"},
+		{"mitochondria", "MT"},
+		{"reserved", ""},
+		{"not on reference assembly", ""},
+		{"23q11", ""},
+		{"0p1", ""},
+		{"123", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.location, func(t *testing.T) {
+			if got := h.ExtractChromosome(tt.location); got != tt.want {
+				t.Errorf("ExtractChromosome(%q) = %q, want %q", tt.location, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetChromosome(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		record *h.Record
+		want   string
+	}{
+		{"TP53", testutil.FixtureTP53(), "17"},
+		{"MIR21", testutil.FixtureMIR21(), "17"},
+		{"mitochondrial", h.NewRecordBuilder().WithSymbol("MT-ND1").WithLocation("mitochondria").Build(), "MT"},
+		{"pseudoautosomal", h.NewRecordBuilder().WithSymbol("SHOX").WithLocation("Xp22.33 and Yp11.2").Build(), "X"},
+		{"no location", h.NewRecordBuilder().WithSymbol("A1BG").Build(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.record.GetChromosome(); got != tt.want {
+				t.Errorf("GetChromosome() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFetchByLocationPrefix(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1(), testutil.FixtureMIR21(),
+		h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("A1BG").WithLocation("19q13.43").Build(),
+		h.NewRecordBuilder().WithHgncID("HGNC:2").WithSymbol("NOLOCATION").Build())
+
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"17", []string{"TP53", "BRCA1", "MIR21"}},
+		{"17q", []string{"BRCA1", "MIR21"}},
+		{"17q2", []string{"BRCA1", "MIR21"}},
+		{"17q21", []string{"BRCA1"}},
+		{" 17p ", []string{"TP53"}},
+		{"1", []string{"TP53", "BRCA1", "MIR21", "A1BG"}},
+		{"X", []string{}},
+		{"", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			got := hgnc.FetchByLocationPrefix(tt.prefix)
+			if got == nil || !slices.Equal(symbols(got), tt.want) {
+				t.Errorf("FetchByLocationPrefix(%q) = %q, want %q", tt.prefix, symbols(got), tt.want)
+			}
+		})
+	}
+}
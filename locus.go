@@ -0,0 +1,66 @@
+package hgnc_go
+
+// LocusGroup is a value of FIELD_LOCUS_GROUP.
+type LocusGroup string
+
+const (
+	LocusGroupProteinCoding LocusGroup = "protein-coding gene"
+	LocusGroupNonCodingRNA  LocusGroup = "non-coding RNA"
+	LocusGroupPseudogene    LocusGroup = "pseudogene"
+	LocusGroupOther         LocusGroup = "other"
+)
+
+// LocusType is a value of FIELD_LOCUS_TYPE, a finer classification within a locus group.
+type LocusType string
+
+const (
+
+	// ---------------- protein-coding gene
+
+	LocusTypeGeneWithProteinProduct LocusType = "gene with protein product"
+
+	// ---------------- non-coding RNA
+
+	LocusTypeRNACluster          LocusType = "RNA, cluster"
+	LocusTypeRNALongNonCoding    LocusType = "RNA, long non-coding"
+	LocusTypeRNAMicro            LocusType = "RNA, micro"
+	LocusTypeRNAMisc             LocusType = "RNA, misc"
+	LocusTypeRNARibosomal        LocusType = "RNA, ribosomal"
+	LocusTypeRNASmallCytoplasmic LocusType = "RNA, small cytoplasmic"
+	LocusTypeRNASmallNuclear     LocusType = "RNA, small nuclear"
+	LocusTypeRNASmallNucleolar   LocusType = "RNA, small nucleolar"
+	LocusTypeRNATransfer         LocusType = "RNA, transfer"
+	LocusTypeRNAVault            LocusType = "RNA, vault"
+	LocusTypeRNAY                LocusType = "RNA, Y"
+
+	// ---------------- pseudogene
+
+	LocusTypePseudogene               LocusType = "pseudogene"
+	LocusTypeImmunoglobulinPseudogene LocusType = "immunoglobulin pseudogene"
+	LocusTypeTCellReceptorPseudogene  LocusType = "T cell receptor pseudogene"
+
+	// ---------------- other
+
+	LocusTypeComplexLocusConstituent LocusType = "complex locus constituent"
+	LocusTypeEndogenousRetrovirus    LocusType = "endogenous retrovirus"
+	LocusTypeFragileSite             LocusType = "fragile site"
+	LocusTypeImmunoglobulinGene      LocusType = "immunoglobulin gene"
+	LocusTypeTCellReceptorGene       LocusType = "T cell receptor gene"
+	LocusTypeProtocadherin           LocusType = "protocadherin"
+	LocusTypeReadthrough             LocusType = "readthrough"
+	LocusTypeRegion                  LocusType = "region"
+	LocusTypeUnknown                 LocusType = "unknown"
+	LocusTypeVirusIntegrationSite    LocusType = "virus integration site"
+)
+
+// LocusGroupEnum returns the locus group of the Record as a LocusGroup.
+// Values not among the constants are returned as is.
+func (r *Record) LocusGroupEnum() LocusGroup {
+	return LocusGroup(r.data[FIELD_LOCUS_GROUP])
+}
+
+// LocusTypeEnum returns the locus type of the Record as a LocusType.
+// Values not among the constants are returned as is.
+func (r *Record) LocusTypeEnum() LocusType {
+	return LocusType(r.data[FIELD_LOCUS_TYPE])
+}
@@ -0,0 +1,84 @@
+package hgnc_go
+
+import "unsafe"
+
+// MemoryStats is an estimate of the heap memory held by an HGNC database, in bytes.
+type MemoryStats struct {
+	RecordBytes    int64 // records and their field values
+	CacheBytes     int64 // caches of indexed fields
+	SymbolMapBytes int64 // standard / alias / previous symbol maps
+	TotalBytes     int64 // sum of the above
+}
+
+/*
+Estimation methodology
+
+Go maps and strings don't expose their real size, so MemoryStats sums:
+  - the bytes of every string (keys and values),
+  - the headers stored in map entries (string: 16 bytes, slice: 24 bytes on 64-bit),
+  - a fixed per-entry overhead for map buckets (mapEntryOverhead),
+  - a fixed per-map overhead (mapOverhead), and the []int backing arrays of caches.
+
+Strings shared between records (e.g. field names) are counted once per use, which
+over-estimates a bit; allocator rounding is ignored, which under-estimates a bit.
+The result is meant to be within 2x of the real footprint, not exact.
+*/
+
+const (
+	mapOverhead      = 48 // map header
+	mapEntryOverhead = 16 // buckets, tophash, load factor slack, per entry
+)
+
+var (
+	stringHeaderSize = int64(unsafe.Sizeof(""))
+	sliceHeaderSize  = int64(unsafe.Sizeof([]int(nil)))
+	intSize          = int64(unsafe.Sizeof(int(0)))
+	pointerSize      = int64(unsafe.Sizeof(uintptr(0)))
+)
+
+// MemoryStats estimates the memory footprint of the database.
+func (h *HGNC) MemoryStats() MemoryStats {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var stats MemoryStats
+
+	// records: slice of pointers, Record structs, data maps
+	stats.RecordBytes = int64(cap(h.records)) * pointerSize
+	for _, record := range h.records {
+		stats.RecordBytes += int64(unsafe.Sizeof(*record)) + mapOverhead
+		for field, value := range record.data {
+			stats.RecordBytes += 2*stringHeaderSize + mapEntryOverhead + int64(len(field)) + int64(len(value))
+		}
+	}
+
+	// caches: map[string][]int per field
+	for field, cache := range h.caches {
+		stats.CacheBytes += stringHeaderSize + pointerSize + mapEntryOverhead + int64(len(field))
+		if cache == nil {
+			continue
+		}
+		stats.CacheBytes += mapOverhead
+		for value, indexes := range cache {
+			stats.CacheBytes += stringHeaderSize + sliceHeaderSize + mapEntryOverhead
+			stats.CacheBytes += int64(len(value)) + int64(cap(indexes))*intSize
+		}
+	}
+
+	// symbol maps
+	stats.SymbolMapBytes = 2 * mapOverhead
+	for symbol, std := range h.geneSymbolMap {
+		stats.SymbolMapBytes += 2*stringHeaderSize + mapEntryOverhead + int64(len(symbol)) + int64(len(std))
+	}
+	for symbol := range h.stdHgncSymbols {
+		stats.SymbolMapBytes += stringHeaderSize + mapEntryOverhead + int64(len(symbol))
+	}
+
+	stats.TotalBytes = stats.RecordBytes + stats.CacheBytes + stats.SymbolMapBytes
+	return stats
+}
@@ -0,0 +1,73 @@
+package hgnc_go_test
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestMemoryStats(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		records int
+		prepare func(hgnc *h.HGNC)
+	}{
+		{"empty", 0, func(*h.HGNC) {}},
+		{"records", 100, func(*h.HGNC) {}},
+		{"with lazy index", 100, func(hgnc *h.HGNC) { hgnc.EnsureIndex(h.FIELD_LOCATION) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hgnc := testutil.NewSyntheticHGNC(tt.records)
+			before := hgnc.MemoryStats()
+			tt.prepare(hgnc)
+			stats := hgnc.MemoryStats()
+
+			if stats.TotalBytes != stats.RecordBytes+stats.CacheBytes+stats.SymbolMapBytes {
+				t.Errorf("TotalBytes %d is not the sum of %+v", stats.TotalBytes, stats)
+			}
+			if stats.RecordBytes < 0 || stats.CacheBytes < 0 || stats.SymbolMapBytes <= 0 {
+				t.Errorf("negative or missing estimates: %+v", stats)
+			}
+			if stats.CacheBytes < before.CacheBytes || stats.RecordBytes != before.RecordBytes {
+				t.Errorf("stats %+v after indexing, %+v before", stats, before)
+			}
+		})
+	}
+
+	small, large := testutil.NewSyntheticHGNC(10).MemoryStats(), testutil.NewSyntheticHGNC(1000).MemoryStats()
+	if large.RecordBytes <= small.RecordBytes || large.CacheBytes <= small.CacheBytes || large.SymbolMapBytes <= small.SymbolMapBytes {
+		t.Errorf("estimates of 1000 records %+v are not larger than of 10 records %+v", large, small)
+	}
+}
+
+// TestMemoryStatsEstimate checks the estimate is within 2x of the heap used by loading.
+func TestMemoryStatsEstimate(t *testing.T) {
+
+	var tsv bytes.Buffer
+	if err := testutil.NewSyntheticHGNC(5000).ExportAllToTSV(&tsv, h.AllFields()); err != nil {
+		t.Fatal(err)
+	}
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	hgnc, err := h.LoadFromReader(bytes.NewReader(tsv.Bytes()), h.WithLogger(testLogger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+
+	heap := int64(after.HeapAlloc) - int64(before.HeapAlloc)
+	estimate := hgnc.MemoryStats().TotalBytes
+	runtime.KeepAlive(hgnc)
+	t.Logf("estimate %d bytes, heap %d bytes", estimate, heap)
+	if estimate < heap/2 || estimate > heap*2 {
+		t.Errorf("MemoryStats estimate %d bytes is not within 2x of the heap used, %d bytes", estimate, heap)
+	}
+}
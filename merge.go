@@ -0,0 +1,98 @@
+package hgnc_go
+
+import "unsafe"
+
+// Merge combines two databases into a new one. Records of supplement sharing an
+// HGNC ID with a base record replace it (supplement wins), other supplement records
+// are appended. Indexes are rebuilt from scratch; fields indexed in either input are
+// indexed in the result. Symbol maps of supplement take priority over those of base.
+// Settings and version metadata are taken from base. Records are shared, not copied.
+func Merge(base, supplement *HGNC) (*HGNC, error) {
+
+	if base == nil || supplement == nil {
+		panic("HGNC is nil")
+	}
+
+	defer rlockPair(base, supplement)()
+
+	h := newHGNC()
+	h.autoNormSymbol = base.autoNormSymbol
+	h.keepEnsgVer = base.keepEnsgVer
+	h.releaseDate = base.releaseDate
+	h.sourceURL = base.sourceURL
+	h.logger = base.logger
+
+	// caches to build
+	for _, src := range []*HGNC{base, supplement} {
+		for field, cache := range src.caches {
+			if cache != nil {
+				h.caches[field] = make(Cache)
+			}
+		}
+	}
+
+	// supplement records by HGNC ID
+	replacements := make(map[string]*Record)
+	for _, record := range supplement.records {
+		if hgncID := record.data[FIELD_HGNC_ID]; hgncID != "" {
+			replacements[hgncID] = record
+		}
+	}
+
+	// base records, replaced where needed
+	replaced := make(map[string]struct{})
+	h.records = make([]*Record, 0, len(base.records)+len(supplement.records))
+	for _, record := range base.records {
+		hgncID := record.data[FIELD_HGNC_ID]
+		if replacement, ok := replacements[hgncID]; ok {
+			if _, done := replaced[hgncID]; done {
+				// duplicated HGNC ID in base, keep a single replacement
+				continue
+			}
+			base.log().Warn("hgnc: merge replaces record", "hgnc_id", hgncID)
+			replaced[hgncID] = struct{}{}
+			record = replacement
+		}
+		h.records = append(h.records, record)
+	}
+
+	// new supplement records
+	for _, record := range supplement.records {
+		if _, ok := replaced[record.data[FIELD_HGNC_ID]]; ok {
+			continue
+		}
+		h.records = append(h.records, record)
+	}
+
+	// supplement symbol maps take priority, also when indexes are rebuilt later
+	h.symbolPriority = make(map[string]string, len(base.symbolPriority)+len(supplement.geneSymbolMap))
+	for symbol, stdSymbol := range base.symbolPriority {
+		h.symbolPriority[symbol] = stdSymbol
+	}
+	for symbol, stdSymbol := range supplement.geneSymbolMap {
+		h.symbolPriority[symbol] = stdSymbol
+	}
+
+	h.rebuildIndexes()
+
+	return h, nil
+}
+
+// rlockPair read-locks a and b (once if they are the same) in the order of their addresses,
+// so concurrent calls with swapped arguments can't deadlock while a writer waits.
+// It returns the function unlocking both.
+func rlockPair(a, b *HGNC) func() {
+	if a == b {
+		a.mu.RLock()
+		return a.mu.RUnlock
+	}
+	if uintptr(unsafe.Pointer(a)) > uintptr(unsafe.Pointer(b)) {
+		a, b = b, a
+	}
+	a.mu.RLock()
+	b.mu.RLock()
+	return func() {
+		b.mu.RUnlock()
+		a.mu.RUnlock()
+	}
+}
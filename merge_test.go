@@ -0,0 +1,131 @@
+package hgnc_go_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+// newLoggedHGNC is testutil.NewMockHGNC with a logger writing to buf.
+func newLoggedHGNC(t *testing.T, buf *bytes.Buffer, records ...*h.Record) *h.HGNC {
+	t.Helper()
+	header := strings.Join(h.AllFieldNames(), "\t") + "\n"
+	logger := slog.New(slog.NewTextHandler(buf, nil))
+	hgnc, err := h.LoadFromReader(strings.NewReader(header), h.WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, record := range records {
+		hgnc.AddRecord(record)
+	}
+	return hgnc
+}
+
+func gene(hgncID, symbol string, aliases ...string) *h.Record {
+	return h.NewRecordBuilder().WithHgncID(hgncID).WithSymbol(symbol).WithAliasSymbols(aliases...).Build()
+}
+
+func TestMerge(t *testing.T) {
+
+	var logs bytes.Buffer
+	base := newLoggedHGNC(t, &logs, gene("HGNC:1", "GENEA"), gene("HGNC:2", "GENEB", "SHARED"))
+	// the replacement of GENEA claims the alias of GENEB, records alone would map it to GENEB
+	supplement := testutil.NewMockHGNC(gene("HGNC:1", "GENEA", "SHARED"), gene("HGNC:3", "GENEC"))
+
+	merged, err := h.Merge(base, supplement)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := merged.RecordCount(); got != 3 {
+		t.Errorf("merged %d records, want 3", got)
+	}
+	if !strings.Contains(logs.String(), "level=WARN msg=\"hgnc: merge replaces record\" hgnc_id=HGNC:1") {
+		t.Errorf("replacement not logged at WARN:\n%s", logs.String())
+	}
+
+	normalized := func() string {
+		records := merged.Fetch("SHARED", h.FIELD_SYMBOL)
+		if len(records) != 1 {
+			return ""
+		}
+		return records[0].Symbol()
+	}
+
+	tests := []struct {
+		name   string
+		mutate func()
+		want   string
+	}{
+		{"after merge", func() {}, "GENEA"},
+		{"after RebuildIndexes", func() { merged.RebuildIndexes() }, "GENEA"},
+		{"after AddIndex", func() { merged.RemoveIndex(h.FIELD_NAME); merged.AddIndex(h.FIELD_NAME) }, "GENEA"},
+		{"after UpdateRecord", func() { merged.UpdateRecord("HGNC:3", gene("HGNC:3", "GENEC", "OTHER")) }, "GENEA"},
+		{"after AddRecord", func() { merged.AddRecord(gene("HGNC:4", "GENED", "SHARED")) }, "GENEA"},
+		{"supplement record removed", func() { merged.RemoveRecord("HGNC:1") }, "GENED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mutate()
+			if got := normalized(); got != tt.want {
+				t.Errorf("SHARED normalized to %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// runConcurrently calls each of fns repeatedly in its own goroutine, together with
+// writers on a and b, and fails if they don't finish in time (a deadlock).
+func runConcurrently(t *testing.T, a, b *h.HGNC, fns ...func()) {
+	t.Helper()
+
+	const iterations = 200
+	var wg sync.WaitGroup
+	for _, fn := range fns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				fn()
+			}
+		}()
+	}
+	for _, db := range []*h.HGNC{a, b} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				db.AddRecord(gene(fmt.Sprintf("HGNC:%d", 1000+i), fmt.Sprintf("NEW%d", i)))
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("deadlock: concurrent calls didn't finish")
+	}
+}
+
+func TestMergeConcurrent(t *testing.T) {
+
+	a := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1())
+	b := testutil.NewMockHGNC(testutil.FixtureBRCA1(), testutil.FixtureMIR21())
+
+	runConcurrently(t, a, b,
+		func() { h.Merge(a, b) },
+		func() { h.Merge(b, a) },
+		func() { h.Merge(a, a) },
+	)
+}
@@ -0,0 +1,11 @@
+package hgnc_go
+
+import "time"
+
+// queryObserver receives measurements of Fetch and Lookup calls,
+// see WithMetrics (available with the prometheus build tag).
+type queryObserver interface {
+	// observeQuery is called when a query on field finishes, cached tells whether
+	// the value was found in a built cache, start is when the query started.
+	observeQuery(field Field, cached bool, start time.Time)
+}
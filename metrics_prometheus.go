@@ -0,0 +1,105 @@
+//go:build prometheus
+
+// The github.com/prometheus/client_golang module is only compiled in with the prometheus tag:
+//
+//	go build -tags prometheus
+
+package hgnc_go
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// promMetrics collects Prometheus metrics of Fetch and Lookup calls.
+type promMetrics struct {
+	fetchTotal    *prometheus.CounterVec   // labels: field, cached
+	fetchDuration *prometheus.HistogramVec // labels: field
+	cacheHits     *prometheus.CounterVec   // labels: field
+	cacheMisses   *prometheus.CounterVec   // labels: field
+	gatherer      prometheus.Gatherer      // serves MetricsHandler
+}
+
+// WithMetrics registers query metrics on reg and makes Fetch and Lookup update them:
+// hgnc_fetch_total{field, cached}, hgnc_fetch_duration_seconds{field},
+// hgnc_cache_hits_total{field} (value found in a cache) and hgnc_cache_misses_total{field}
+// (value not in the cache, or a query scanning all records).
+// Loading several times with the same reg shares the metrics.
+func WithMetrics(reg prometheus.Registerer) LoadOption {
+	return func(cfg *loadConfig) {
+		cfg.metrics = newPromMetrics(reg)
+	}
+}
+
+// newPromMetrics creates metrics and registers them on reg, reusing already registered ones.
+func newPromMetrics(reg prometheus.Registerer) *promMetrics {
+
+	m := &promMetrics{
+		fetchTotal: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "hgnc_fetch_total",
+			Help: "Number of Fetch and Lookup queries.",
+		}, []string{"field", "cached"})),
+		fetchDuration: register(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "hgnc_fetch_duration_seconds",
+			Help:    "Duration of Fetch and Lookup queries.",
+			Buckets: prometheus.ExponentialBuckets(1e-6, 10, 7), // 1µs .. 1s
+		}, []string{"field"})),
+		cacheHits: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "hgnc_cache_hits_total",
+			Help: "Number of queries whose value was found in a cache.",
+		}, []string{"field"})),
+		cacheMisses: register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "hgnc_cache_misses_total",
+			Help: "Number of queries not found in a cache or scanning all records.",
+		}, []string{"field"})),
+		gatherer: prometheus.DefaultGatherer,
+	}
+	if g, ok := reg.(prometheus.Gatherer); ok {
+		m.gatherer = g
+	}
+	return m
+}
+
+// register registers c on reg, or returns the collector registered before.
+func register[C prometheus.Collector](reg prometheus.Registerer, c C) C {
+	if err := reg.Register(c); err != nil {
+		var are prometheus.AlreadyRegisteredError
+		if errors.As(err, &are) {
+			if existing, ok := are.ExistingCollector.(C); ok {
+				return existing
+			}
+		}
+		panic(err)
+	}
+	return c
+}
+
+func (m *promMetrics) observeQuery(field Field, cached bool, start time.Time) {
+	m.fetchTotal.WithLabelValues(string(field), strconv.FormatBool(cached)).Inc()
+	m.fetchDuration.WithLabelValues(string(field)).Observe(time.Since(start).Seconds())
+	if cached {
+		m.cacheHits.WithLabelValues(string(field)).Inc()
+	} else {
+		m.cacheMisses.WithLabelValues(string(field)).Inc()
+	}
+}
+
+// MetricsHandler returns the Prometheus /metrics handler of the registry passed to
+// WithMetrics, or of the default registry if it's not a Gatherer or metrics are not set.
+func (h *HGNC) MetricsHandler() http.Handler {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	gatherer := prometheus.DefaultGatherer
+	if m, ok := h.metrics.(*promMetrics); ok {
+		gatherer = m.gatherer
+	}
+	return promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,128 @@
+//go:build prometheus
+
+package hgnc_go_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+// newMetricsHGNC is testutil.NewMockHGNC with metrics registered on reg.
+func newMetricsHGNC(t *testing.T, reg prometheus.Registerer, records ...*h.Record) *h.HGNC {
+	header := strings.Join(h.AllFieldNames(), "\t") + "\n"
+	hgnc, err := h.LoadFromReader(strings.NewReader(header), h.WithMetrics(reg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, record := range records {
+		hgnc.AddRecord(record)
+	}
+	return hgnc
+}
+
+// counterValue returns the value of counter name with labels in reg, 0 if it's not collected.
+func counterValue(t *testing.T, reg prometheus.Gatherer, name string, labels map[string]string) float64 {
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if matchLabels(metric.GetLabel(), labels) {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func matchLabels(pairs []*dto.LabelPair, labels map[string]string) bool {
+	if len(pairs) != len(labels) {
+		return false
+	}
+	for _, pair := range pairs {
+		if labels[pair.GetName()] != pair.GetValue() {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRegistry(t *testing.T) {
+
+	reg := prometheus.NewRegistry()
+	hgnc := newMetricsHGNC(t, reg, testutil.FixtureTP53(), testutil.FixtureBRCA1())
+	hgnc.RemoveIndex(h.FIELD_NAME)
+
+	tests := []struct {
+		name   string
+		query  func()
+		field  h.Field
+		cached bool
+	}{
+		{"fetch symbol", func() { hgnc.Fetch("TP53", h.FIELD_SYMBOL) }, h.FIELD_SYMBOL, true},
+		{"fetch alias", func() { hgnc.Fetch("p53", h.FIELD_SYMBOL) }, h.FIELD_SYMBOL, true},
+		{"fetch unknown symbol", func() { hgnc.Fetch("NOPE", h.FIELD_SYMBOL) }, h.FIELD_SYMBOL, false},
+		{"lookup entrez", func() { hgnc.Lookup("672", h.FIELD_ENTREZ_ID, h.FIELD_SYMBOL) }, h.FIELD_ENTREZ_ID, true},
+		{"fetch without index", func() { hgnc.Fetch("tumor protein p53", h.FIELD_NAME) }, h.FIELD_NAME, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field := string(tt.field)
+			cached := "false"
+			if tt.cached {
+				cached = "true"
+			}
+			total := counterValue(t, reg, "hgnc_fetch_total", map[string]string{"field": field, "cached": cached})
+			hits := counterValue(t, reg, "hgnc_cache_hits_total", map[string]string{"field": field})
+			misses := counterValue(t, reg, "hgnc_cache_misses_total", map[string]string{"field": field})
+
+			tt.query()
+
+			if got := counterValue(t, reg, "hgnc_fetch_total", map[string]string{"field": field, "cached": cached}); got != total+1 {
+				t.Errorf("hgnc_fetch_total{field=%q, cached=%q} = %v, want %v", field, cached, got, total+1)
+			}
+			wantHits, wantMisses := hits, misses+1
+			if tt.cached {
+				wantHits, wantMisses = hits+1, misses
+			}
+			if got := counterValue(t, reg, "hgnc_cache_hits_total", map[string]string{"field": field}); got != wantHits {
+				t.Errorf("hgnc_cache_hits_total{field=%q} = %v, want %v", field, got, wantHits)
+			}
+			if got := counterValue(t, reg, "hgnc_cache_misses_total", map[string]string{"field": field}); got != wantMisses {
+				t.Errorf("hgnc_cache_misses_total{field=%q} = %v, want %v", field, got, wantMisses)
+			}
+		})
+	}
+}
+
+func TestMetricsHandler(t *testing.T) {
+
+	reg := prometheus.NewRegistry()
+	hgnc := newMetricsHGNC(t, reg, testutil.FixtureTP53())
+	hgnc.Fetch("TP53", h.FIELD_SYMBOL)
+
+	rec := httptest.NewRecorder()
+	hgnc.MetricsHandler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+	for _, want := range []string{
+		`hgnc_fetch_total{cached="true",field="symbol"} 1`,
+		`hgnc_cache_hits_total{field="symbol"} 1`,
+		`hgnc_fetch_duration_seconds_count{field="symbol"} 1`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("/metrics doesn't contain %q:\n%s", want, body)
+		}
+	}
+}
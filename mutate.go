@@ -0,0 +1,101 @@
+package hgnc_go
+
+// AddRecord appends a record to HGNC and adds it to symbol maps and caches.
+func (h *HGNC) AddRecord(record *Record) {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.records = append(h.records, record)
+	h.version++
+	h.modified = true
+	h.indexRecord(len(h.records)-1, record)
+}
+
+// RemoveRecord removes all records with the given HGNC ID, then rebuilds indexes.
+// It returns the number of removed records.
+func (h *HGNC) RemoveRecord(hgncID string) int {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	kept := make([]*Record, 0, len(h.records))
+	for _, record := range h.records {
+		if record.data[FIELD_HGNC_ID] != hgncID {
+			kept = append(kept, record)
+		}
+	}
+	removed := len(h.records) - len(kept)
+	if removed > 0 {
+		h.records = kept
+		h.modified = true
+		h.rebuildIndexes()
+	}
+	return removed
+}
+
+// UpdateRecord replaces the record with the given HGNC ID, then rebuilds indexes.
+// It returns false if no record has the HGNC ID.
+func (h *HGNC) UpdateRecord(hgncID string, record *Record) bool {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	updated := false
+	for i, r := range h.records {
+		if r.data[FIELD_HGNC_ID] == hgncID {
+			h.records[i] = record
+			updated = true
+			break
+		}
+	}
+	if updated {
+		h.modified = true
+		h.rebuildIndexes()
+	}
+	return updated
+}
+
+// AddIndex builds a cache for the field, so Fetch and Lookup on it become O(1).
+// It does nothing if the field is already indexed.
+func (h *HGNC) AddIndex(field Field) {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if cache := h.caches[field]; cache != nil {
+		return
+	}
+	h.caches[field] = buildCache(h.records, field)
+}
+
+// RemoveIndex drops the cache of the field, Fetch and Lookup on it fall back to linear scan.
+func (h *HGNC) RemoveIndex(field Field) {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.caches, field)
+	delete(h.indexOnce, field)
+	delete(h.ciCaches, field)
+}
@@ -0,0 +1,83 @@
+package hgnc_go_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+// TestConcurrentReadWrite runs readers together with writers, run it with -race.
+func TestConcurrentReadWrite(t *testing.T) {
+
+	readers := []struct {
+		name string
+		read func(hgnc *h.HGNC, i int)
+	}{
+		{"Fetch", func(hgnc *h.HGNC, i int) { hgnc.Fetch(fmt.Sprintf("ALIAS%d", i), h.FIELD_SYMBOL) }},
+		{"Fetch lazy index", func(hgnc *h.HGNC, i int) { hgnc.Fetch(fmt.Sprintf("OTTHUMG%011d", i+1), h.FIELD_VEGA_ID) }},
+		{"FetchCI", func(hgnc *h.HGNC, i int) { hgnc.FetchCI(fmt.Sprintf("gene%d", i), h.FIELD_SYMBOL) }},
+		{"Lookup", func(hgnc *h.HGNC, i int) { hgnc.Lookup(fmt.Sprint(i+1), h.FIELD_ENTREZ_ID, h.FIELD_SYMBOL) }},
+		{"Filter", func(hgnc *h.HGNC, _ int) { hgnc.Filter(func(r *h.Record) bool { return r.Symbol() != "" }) }},
+		{"ParallelFilter", func(hgnc *h.HGNC, _ int) { hgnc.ParallelFilter(func(r *h.Record) bool { return r.Symbol() != "" }, 4) }},
+		{"MultiQuery", func(hgnc *h.HGNC, i int) { hgnc.MultiQuery(fmt.Sprintf("GENE%d", i)) }},
+		{"BatchFetch", func(hgnc *h.HGNC, i int) { hgnc.BatchFetch([]string{fmt.Sprintf("GENE%d", i), "NEW1"}, h.FIELD_SYMBOL) }},
+		{"Stats", func(hgnc *h.HGNC, _ int) { hgnc.Stats() }},
+	}
+	writers := []func(hgnc *h.HGNC, i int){
+		func(hgnc *h.HGNC, i int) {
+			hgnc.AddRecord(h.NewRecordBuilder().WithHgncID(fmt.Sprintf("HGNC:%d", 100000+i)).WithSymbol(fmt.Sprintf("NEW%d", i)).Build())
+		},
+		func(hgnc *h.HGNC, i int) {
+			hgnc.UpdateRecord(fmt.Sprintf("HGNC:%d", i+1), h.NewRecordBuilder().WithHgncID(fmt.Sprintf("HGNC:%d", i+1)).WithSymbol(fmt.Sprintf("GENE%d", i)).Build())
+		},
+		func(hgnc *h.HGNC, i int) {
+			if i%10 == 0 {
+				hgnc.RemoveIndex(h.FIELD_LOCATION)
+				hgnc.AddIndex(h.FIELD_LOCATION)
+			}
+		},
+		func(hgnc *h.HGNC, i int) {
+			if i%25 == 0 {
+				hgnc.RebuildIndexes()
+			}
+		},
+	}
+
+	const iterations = 100
+	for _, reader := range readers {
+		t.Run(reader.name, func(t *testing.T) {
+			hgnc := testutil.NewSyntheticHGNC(200)
+
+			var wg sync.WaitGroup
+			for _, fn := range writers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < iterations; i++ {
+						fn(hgnc, i)
+					}
+				}()
+			}
+			for g := 0; g < 4; g++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < iterations; i++ {
+						reader.read(hgnc, i)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if got := hgnc.RecordCount(); got != 200+iterations {
+				t.Errorf("RecordCount() = %d, want %d", got, 200+iterations)
+			}
+			if got := len(hgnc.Fetch(fmt.Sprintf("NEW%d", iterations-1), h.FIELD_SYMBOL)); got != 1 {
+				t.Errorf("Fetch of the last added record returned %d records, want 1", got)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package hgnc_go
 
-import "strings"
+import (
+	"sort"
+	"strings"
+)
 
 // normalizeSymbol converts alias/previous symbols to standard HGNC symbols.
 func (h *HGNC) normalizeSymbol(symbol string) string {
@@ -18,3 +21,83 @@ func (h *HGNC) normalizeSymbol(symbol string) string {
 	}
 	return symbol
 }
+
+// GetAllStandardSymbols returns all standard HGNC symbols, sorted.
+func (h *HGNC) GetAllStandardSymbols() []string {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	results := make([]string, 0, len(h.stdHgncSymbols))
+	for symbol := range h.stdHgncSymbols {
+		results = append(results, symbol)
+	}
+	sort.Strings(results)
+	return results
+}
+
+// GetAllAliasSymbols returns all alias symbols of records with a standard symbol, deduplicated and sorted.
+func (h *HGNC) GetAllAliasSymbols() []string {
+	return h.collectSymbols((*Record).GetAliasSymbols)
+}
+
+// GetAllPrevSymbols returns all previous symbols of records with a standard symbol, deduplicated and sorted.
+func (h *HGNC) GetAllPrevSymbols() []string {
+	return h.collectSymbols((*Record).GetPrevSymbols)
+}
+
+// collectSymbols gathers symbols returned by get for all records, the same records as geneSymbolMap.
+func (h *HGNC) collectSymbols(get func(*Record) []string) []string {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	seen := make(map[string]struct{})
+	for _, record := range h.records {
+		if strings.TrimSpace(record.data[FIELD_SYMBOL]) == "" {
+			continue
+		}
+		for _, symbol := range get(record) {
+			seen[symbol] = struct{}{}
+		}
+	}
+
+	results := make([]string, 0, len(seen))
+	for symbol := range seen {
+		results = append(results, symbol)
+	}
+	sort.Strings(results)
+	return results
+}
+
+// BulkNormalizeSymbols converts many symbols (standard, alias or previous) to standard
+// HGNC symbols under a single lock. The result maps each input to its standard symbol,
+// symbols not found are absent. Unlike Fetch, it normalizes even if auto normalization is off.
+func (h *HGNC) BulkNormalizeSymbols(symbols []string) map[string]string {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	results := make(map[string]string, len(symbols))
+	for _, input := range symbols {
+		symbol := strings.TrimSpace(input)
+		if _, ok := h.stdHgncSymbols[symbol]; ok {
+			results[input] = symbol
+		} else if stdSymbol, ok := h.geneSymbolMap[symbol]; ok {
+			results[input] = stdSymbol
+		}
+	}
+	return results
+}
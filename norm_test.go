@@ -0,0 +1,103 @@
+package hgnc_go
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// newNormHGNC returns a database of n genes GENE<i>, with alias ALIAS<i> and previous symbol OLD<i>.
+func newNormHGNC(tb testing.TB, n int) *HGNC {
+	h, err := LoadFromReader(strings.NewReader(fuzzHeader), WithLogger(discardLogger))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		h.AddRecord(NewRecordBuilder().
+			WithHgncID(fmt.Sprintf("HGNC:%d", i+1)).
+			WithSymbol(fmt.Sprintf("GENE%d", i)).
+			WithAliasSymbols(fmt.Sprintf("ALIAS%d", i)).
+			WithPrevSymbols(fmt.Sprintf("OLD%d", i)).
+			Build())
+	}
+	return h
+}
+
+func TestNormalizeSymbol(t *testing.T) {
+
+	h := newNormHGNC(t, 3)
+
+	tests := []struct {
+		name   string
+		symbol string
+		auto   bool
+		want   string
+	}{
+		{"standard symbol", "GENE1", true, "GENE1"},
+		{"alias", "ALIAS1", true, "GENE1"},
+		{"previous symbol", "OLD2", true, "GENE2"},
+		{"surrounding spaces", " ALIAS0\t", true, "GENE0"},
+		{"unknown", "NOPE", true, "NOPE"},
+		{"normalization disabled", " ALIAS1 ", false, "ALIAS1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h.SetAutoNormSymbol(tt.auto)
+			if got := h.normalizeSymbol(tt.symbol); got != tt.want {
+				t.Errorf("normalizeSymbol(%q) = %q, want %q", tt.symbol, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkNormalizeSymbol(b *testing.B) {
+
+	const n = 45000 // about the number of records in the HGNC complete set
+	h := newNormHGNC(b, n)
+	symbols := make([]string, n)
+	for i := range symbols {
+		// standard symbols, aliases and previous symbols
+		symbols[i] = fmt.Sprintf([]string{"GENE%d", "ALIAS%d", "OLD%d"}[i%3], i)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.normalizeSymbol(symbols[i%n])
+	}
+}
+
+func TestGetAllSymbols(t *testing.T) {
+
+	h := newNormHGNC(t, 3)
+	h.AddRecord(NewRecordBuilder().WithHgncID("HGNC:10").WithSymbol("A1BG").WithAliasSymbols("ALIAS1", "ZZZ").Build())
+	h.AddRecord(NewRecordBuilder().WithHgncID("HGNC:11").WithAliasSymbols("NOSYMBOL").Build())
+
+	tests := []struct {
+		name string
+		get  func() []string
+		want []string
+	}{
+		{"standard", h.GetAllStandardSymbols, []string{"A1BG", "GENE0", "GENE1", "GENE2"}},
+		{"alias", h.GetAllAliasSymbols, []string{"ALIAS0", "ALIAS1", "ALIAS2", "ZZZ"}},
+		{"previous", h.GetAllPrevSymbols, []string{"OLD0", "OLD1", "OLD2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.get()
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			// a snapshot, changing it doesn't affect the database
+			got[0] = "CHANGED"
+			if again := tt.get(); !slices.Equal(again, tt.want) {
+				t.Errorf("after changing the result, got %q, want %q", again, tt.want)
+			}
+		})
+	}
+
+	if got := newNormHGNC(t, 0).GetAllStandardSymbols(); got == nil || len(got) != 0 {
+		t.Errorf("GetAllStandardSymbols of an empty database = %#v, want an empty slice", got)
+	}
+}
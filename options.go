@@ -0,0 +1,129 @@
+package hgnc_go
+
+import (
+	"crypto"
+	"log/slog"
+)
+
+// LoadOption configures how HGNC data is loaded, e.g. LoadTsv(path, true, WithProgressCallback(cb)).
+type LoadOption func(*loadConfig)
+
+// loadConfig holds settings of a single load, assembled from LoadOptions.
+type loadConfig struct {
+	progress  func(linesRead, totalBytes int64) // progress callback, nil if not set
+	validate  bool                              // whether to validate header columns
+	strict    bool                              // whether validation issues are fatal
+	delimiter rune                              // column delimiter, '\t' by default
+	intern    bool                              // whether to intern repeated field values
+
+	caseInsensitive bool          // whether to build case-insensitive caches of indexed fields
+	checksum        *checksum     // expected checksum of the data, nil if not checked
+	skipIndex       bool          // whether to leave indexes empty, used by LoadTsvWithIndex
+	metrics         queryObserver // receives Fetch / Lookup measurements, nil if not set
+	logger          *slog.Logger  // logger of load and query events, nil for slog.Default()
+
+	ensgVersionStripping bool              // see HGNC.SetEnsgVersionStripping, true by default
+	validationOut        *ValidationResult // receives the header validation result, see LoadTsvWithValidation
+}
+
+// newLoadConfig applies opts on the default config.
+func newLoadConfig(opts []LoadOption) *loadConfig {
+	cfg := &loadConfig{delimiter: '\t', ensgVersionStripping: true}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(cfg)
+		}
+	}
+	return cfg
+}
+
+// progressInterval is the number of lines between two progress callbacks.
+const progressInterval = 1000
+
+// WithProgressCallback makes the loader call cb every 1000 lines (and once at the end)
+// with the number of records parsed and the number of bytes consumed so far.
+// totalBytes is -1 when it can't be known, e.g. for gzipped input.
+func WithProgressCallback(cb func(linesRead, totalBytes int64)) LoadOption {
+	return func(cfg *loadConfig) {
+		cfg.progress = cb
+	}
+}
+
+// reportProgress calls the progress callback.
+func (cfg *loadConfig) reportProgress(linesRead, bytesRead int64, sizeKnown bool) {
+	if !sizeKnown {
+		bytesRead = -1
+	}
+	cfg.progress(linesRead, bytesRead)
+}
+
+// WithValidation checks header columns against known fields while loading.
+// Issues are available from HGNC.ValidationResult(), or returned directly by
+// LoadTsvWithValidation. If strict is true, loading fails when any indexed field
+// column is missing. Unknown columns are logged as warnings with or without it.
+func WithValidation(strict bool) LoadOption {
+	return func(cfg *loadConfig) {
+		cfg.validate = true
+		cfg.strict = strict
+	}
+}
+
+// WithDelimiter sets the column delimiter, e.g. ',' for CSV or '|' for pipe-delimited files.
+// Any delimiter other than '\t' is parsed with RFC 4180 quoting rules.
+func WithDelimiter(delimiter rune) LoadOption {
+	return func(cfg *loadConfig) {
+		cfg.delimiter = delimiter
+	}
+}
+
+// WithInterning makes the loader share one copy of each distinct field value among records.
+// Many values repeat (status, locus group, locus type, dates, ...), so this reduces
+// heap allocations and memory footprint at the cost of a map lookup per value.
+func WithInterning() LoadOption {
+	return func(cfg *loadConfig) {
+		cfg.intern = true
+	}
+}
+
+// WithCaseInsensitiveIndex builds case-insensitive caches of the default indexed fields
+// while loading, instead of on the first FetchCI call on each field.
+func WithCaseInsensitiveIndex() LoadOption {
+	return func(cfg *loadConfig) {
+		cfg.caseInsensitive = true
+	}
+}
+
+// WithChecksum checks the data against an expected digest, e.g. WithChecksum(digest, crypto.SHA256).
+// For files, the digest is of the file as stored (compressed if gzipped) and is checked
+// before parsing. For readers, it is checked after reading, and loading fails on mismatch
+// with an error wrapping ErrChecksumMismatch.
+func WithChecksum(expected []byte, algorithm crypto.Hash) LoadOption {
+	return func(cfg *loadConfig) {
+		cfg.checksum = &checksum{expected: expected, algorithm: algorithm}
+	}
+}
+
+// WithLogger sets the logger of load and query events, slog.Default() if not set.
+// Load start / completion is logged at INFO, unknown columns at WARN, malformed rows at
+// ERROR, and Fetch / Lookup queries at DEBUG.
+func WithLogger(l *slog.Logger) LoadOption {
+	return func(cfg *loadConfig) {
+		cfg.logger = l
+	}
+}
+
+// WithEnsgVersionStripping sets whether converters strip the version suffix of ensembl gene ids,
+// enabled by default. See HGNC.SetEnsgVersionStripping.
+func WithEnsgVersionStripping(enabled bool) LoadOption {
+	return func(cfg *loadConfig) {
+		cfg.ensgVersionStripping = enabled
+	}
+}
+
+// log returns the logger of the load.
+func (cfg *loadConfig) log() *slog.Logger {
+	if cfg.logger != nil {
+		return cfg.logger
+	}
+	return slog.Default()
+}
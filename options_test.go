@@ -0,0 +1,138 @@
+package hgnc_go_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+// testLogger keeps loads quiet in test output.
+var testLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// syntheticTSV returns testutil.SyntheticRecords(n) in the HGNC file format.
+func syntheticTSV(t *testing.T, n int) []byte {
+	var buf bytes.Buffer
+	if err := testutil.NewMockHGNC().ExportToTSV(&buf, testutil.SyntheticRecords(n), h.AllFields()); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestWithProgressCallback(t *testing.T) {
+
+	type progress struct{ lines, bytes int64 }
+
+	tests := []struct {
+		name      string
+		records   int
+		gzipped   bool
+		wantLines []int64
+	}{
+		{"no records", 0, false, nil},
+		{"fewer than the interval", 10, false, []int64{10}},
+		{"multiple of the interval", 2000, false, []int64{1000, 2000}},
+		{"remainder", 2500, false, []int64{1000, 2000, 2500}},
+		{"gzipped", 1500, true, []int64{1000, 1500}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := syntheticTSV(t, tt.records)
+			path := filepath.Join(t.TempDir(), "hgnc.tsv")
+			content := data
+			if tt.gzipped {
+				var buf bytes.Buffer
+				gz := gzip.NewWriter(&buf)
+				gz.Write(data)
+				gz.Close()
+				content = buf.Bytes()
+			}
+			if err := os.WriteFile(path, content, 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			var calls []progress
+			cb := func(lines, bytes int64) { calls = append(calls, progress{lines, bytes}) }
+			if _, err := h.LoadTsv(path, tt.gzipped, h.WithProgressCallback(cb), h.WithLogger(testLogger)); err != nil {
+				t.Fatal(err)
+			}
+
+			lines := make([]int64, len(calls))
+			for i, call := range calls {
+				lines[i] = call.lines
+				switch {
+				case tt.gzipped && call.bytes != -1:
+					t.Errorf("call %d: totalBytes = %d for gzipped input, want -1", i, call.bytes)
+				case !tt.gzipped && i > 0 && call.bytes <= calls[i-1].bytes:
+					t.Errorf("call %d: totalBytes = %d, not increasing", i, call.bytes)
+				}
+			}
+			if !slices.Equal(lines, tt.wantLines) {
+				t.Errorf("progress called with lines %v, want %v", lines, tt.wantLines)
+			}
+			if !tt.gzipped && len(calls) > 0 {
+				if last := calls[len(calls)-1].bytes; last != int64(len(data)) {
+					t.Errorf("last totalBytes = %d, want the file size %d", last, len(data))
+				}
+			}
+		})
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+
+	type entry struct{ level, msg string }
+	valid := header("hgnc_id", "symbol") + "HGNC:11998\tTP53\n"
+
+	tests := []struct {
+		name  string
+		data  string
+		query func(*h.HGNC)
+		want  []entry
+	}{
+		{"load", valid, nil, []entry{{"INFO", "hgnc: loading"}, {"INFO", "hgnc: loaded"}}},
+		{"unknown column", header("hgnc_id", "symbol", "nope") + "HGNC:11998\tTP53\t\n", nil,
+			[]entry{{"INFO", "hgnc: loading"}, {"WARN", "hgnc: unknown columns"}, {"INFO", "hgnc: loaded"}}},
+		{"malformed row", header("hgnc_id", "symbol", "name") + "HGNC:11998\tTP53\n", nil,
+			[]entry{{"INFO", "hgnc: loading"}, {"ERROR", "hgnc: row does not match the header, missing values are left empty"}, {"INFO", "hgnc: loaded"}}},
+		{"fetch", valid, func(hgnc *h.HGNC) { hgnc.Fetch("TP53", h.FIELD_SYMBOL) },
+			[]entry{{"INFO", "hgnc: loading"}, {"INFO", "hgnc: loaded"}, {"DEBUG", "hgnc: fetch"}}},
+		{"lookup", valid, func(hgnc *h.HGNC) { hgnc.Lookup("TP53", h.FIELD_SYMBOL, h.FIELD_HGNC_ID) },
+			[]entry{{"INFO", "hgnc: loading"}, {"INFO", "hgnc: loaded"}, {"DEBUG", "hgnc: lookup"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			hgnc, err := h.LoadFromReader(strings.NewReader(tt.data), h.WithLogger(logger))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.query != nil {
+				tt.query(hgnc)
+			}
+
+			var got []entry
+			decoder := json.NewDecoder(&buf)
+			for decoder.More() {
+				var e struct{ Level, Msg string }
+				if err := decoder.Decode(&e); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, entry{e.Level, e.Msg})
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("logged %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
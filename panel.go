@@ -0,0 +1,197 @@
+package hgnc_go
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Panel is a set of genes (e.g. a clinical gene panel) identified by their standard HGNC symbols.
+// A Panel is not safe for concurrent mutation.
+type Panel struct {
+	h       *HGNC
+	symbols map[string]struct{}
+}
+
+// NewPanel resolves gene identifiers (in any supported id system, aliases included)
+// to standard symbols and returns the panel of them, as well as identifiers not found.
+// An identifier matching records of several symbols is an error.
+func (h *HGNC) NewPanel(geneIdentifiers []string) (*Panel, []string, error) {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	p := newPanel(h)
+	unresolved := make([]string, 0)
+	for _, gene := range geneIdentifiers {
+		symbol, err := p.resolve(gene)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				unresolved = append(unresolved, gene)
+				continue
+			}
+			return nil, nil, err
+		}
+		p.symbols[symbol] = struct{}{}
+	}
+	return p, unresolved, nil
+}
+
+// newPanel creates an empty panel on h.
+func newPanel(h *HGNC) *Panel {
+	return &Panel{h: h, symbols: make(map[string]struct{})}
+}
+
+// Contains checks if a gene (in any supported id system) is in the panel.
+func (p *Panel) Contains(gene string) bool {
+	if _, ok := p.symbols[strings.TrimSpace(gene)]; ok {
+		return true
+	}
+	symbol, err := p.resolve(gene)
+	if err != nil {
+		return false
+	}
+	_, ok := p.symbols[symbol]
+	return ok
+}
+
+// Add resolves a gene and adds it to the panel. Adding a gene twice is not an error.
+func (p *Panel) Add(gene string) error {
+	symbol, err := p.resolve(gene)
+	if err != nil {
+		return err
+	}
+	p.symbols[symbol] = struct{}{}
+	return nil
+}
+
+// Remove resolves a gene and removes it from the panel.
+// It returns an error wrapping ErrNotFound if the gene is not in the panel.
+func (p *Panel) Remove(gene string) error {
+	symbol := strings.TrimSpace(gene)
+	if _, ok := p.symbols[symbol]; !ok {
+		resolved, err := p.resolve(gene)
+		if err != nil {
+			return err
+		}
+		symbol = resolved
+	}
+	if _, ok := p.symbols[symbol]; !ok {
+		return fmt.Errorf("gene %q is not in the panel: %w", gene, ErrNotFound)
+	}
+	delete(p.symbols, symbol)
+	return nil
+}
+
+// Len returns the number of genes in the panel.
+func (p *Panel) Len() int {
+	return len(p.symbols)
+}
+
+// Symbols returns standard symbols of all panel genes, sorted.
+func (p *Panel) Symbols() []string {
+	results := make([]string, 0, len(p.symbols))
+	for symbol := range p.symbols {
+		results = append(results, symbol)
+	}
+	sort.Strings(results)
+	return results
+}
+
+// Records returns records of all panel genes, in the order of Symbols.
+func (p *Panel) Records() []*Record {
+	results := make([]*Record, 0, len(p.symbols))
+	for _, symbol := range p.Symbols() {
+		results = append(results, p.h.Fetch(symbol, FIELD_SYMBOL)...)
+	}
+	return results
+}
+
+// GetOmimIDs returns omim ids of all panel genes, deduplicated and sorted.
+func (p *Panel) GetOmimIDs() []string {
+	return p.collectIDs(FIELD_OMIM_ID)
+}
+
+// GetEntrezIDs returns entrez ids of all panel genes, deduplicated and sorted.
+func (p *Panel) GetEntrezIDs() []string {
+	return p.collectIDs(FIELD_ENTREZ_ID)
+}
+
+// GetEnsemblIDs returns ensembl gene ids of all panel genes, deduplicated and sorted.
+func (p *Panel) GetEnsemblIDs() []string {
+	return p.collectIDs(FIELD_ENSEMBL_GENE_ID)
+}
+
+// collectIDs gathers non-empty values of field (split on "|") of all panel genes.
+func (p *Panel) collectIDs(field Field) []string {
+	seen := make(map[string]struct{})
+	for _, record := range p.Records() {
+		for _, id := range SplitMultiValue(record.data[field]) {
+			seen[id] = struct{}{}
+		}
+	}
+
+	results := make([]string, 0, len(seen))
+	for id := range seen {
+		results = append(results, id)
+	}
+	sort.Strings(results)
+	return results
+}
+
+// resolve converts a gene identifier to its standard symbol.
+func (p *Panel) resolve(gene string) (string, error) {
+
+	records, err := p.h.ResolveGeneAll(strings.TrimSpace(gene))
+	if err != nil {
+		return "", err
+	}
+
+	symbol := records[0].Symbol()
+	for _, record := range records[1:] {
+		if record.Symbol() != symbol {
+			return "", fmt.Errorf("gene %q is ambiguous, matches %s and %s", gene, symbol, record.Symbol())
+		}
+	}
+	return symbol, nil
+}
+
+// IntersectPanels returns a new panel of genes in both a and b.
+func IntersectPanels(a, b *Panel) *Panel {
+	result := newPanel(a.h)
+	for symbol := range a.symbols {
+		if _, ok := b.symbols[symbol]; ok {
+			result.symbols[symbol] = struct{}{}
+		}
+	}
+	return result
+}
+
+// DiffPanels returns new panels of genes only in a, and only in b.
+func DiffPanels(a, b *Panel) (onlyInA, onlyInB *Panel) {
+	return subtractPanel(a, b), subtractPanel(b, a)
+}
+
+// UnionPanels returns a new panel of genes in a or b.
+func UnionPanels(a, b *Panel) *Panel {
+	result := newPanel(a.h)
+	for _, p := range []*Panel{a, b} {
+		for symbol := range p.symbols {
+			result.symbols[symbol] = struct{}{}
+		}
+	}
+	return result
+}
+
+// subtractPanel returns a new panel of genes in a but not in b.
+func subtractPanel(a, b *Panel) *Panel {
+	result := newPanel(a.h)
+	for symbol := range a.symbols {
+		if _, ok := b.symbols[symbol]; !ok {
+			result.symbols[symbol] = struct{}{}
+		}
+	}
+	return result
+}
@@ -0,0 +1,125 @@
+package hgnc_go_test
+
+import (
+	"slices"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+// newPanel is HGNC.NewPanel failing the test on an error or unresolved genes.
+func newPanel(t *testing.T, hgnc *h.HGNC, genes ...string) *h.Panel {
+	t.Helper()
+	panel, unresolved, err := hgnc.NewPanel(genes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unresolved) != 0 {
+		t.Fatalf("NewPanel(%q) didn't resolve %q", genes, unresolved)
+	}
+	return panel
+}
+
+func TestPanelSetOperations(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+
+	tests := []struct {
+		name      string
+		a, b      []string
+		intersect []string
+		onlyInA   []string
+		onlyInB   []string
+		union     []string
+	}{
+		{
+			"overlapping",
+			[]string{"TP53", "BRCA1"}, []string{"BRCA1", "MIR21"},
+			[]string{"BRCA1"}, []string{"TP53"}, []string{"MIR21"}, []string{"BRCA1", "MIR21", "TP53"},
+		},
+		{
+			"other id systems",
+			[]string{"p53", "672"}, []string{"ENSG00000141510", "HGNC:1100"},
+			[]string{"BRCA1", "TP53"}, []string{}, []string{}, []string{"BRCA1", "TP53"},
+		},
+		{
+			"disjoint",
+			[]string{"TP53"}, []string{"MIR21"},
+			[]string{}, []string{"TP53"}, []string{"MIR21"}, []string{"MIR21", "TP53"},
+		},
+		{
+			"empty",
+			[]string{"TP53"}, []string{},
+			[]string{}, []string{"TP53"}, []string{}, []string{"TP53"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := newPanel(t, hgnc, tt.a...), newPanel(t, hgnc, tt.b...)
+
+			if got := h.IntersectPanels(a, b).Symbols(); !slices.Equal(got, tt.intersect) {
+				t.Errorf("IntersectPanels() = %q, want %q", got, tt.intersect)
+			}
+			onlyInA, onlyInB := h.DiffPanels(a, b)
+			if got := onlyInA.Symbols(); !slices.Equal(got, tt.onlyInA) {
+				t.Errorf("DiffPanels() only in a = %q, want %q", got, tt.onlyInA)
+			}
+			if got := onlyInB.Symbols(); !slices.Equal(got, tt.onlyInB) {
+				t.Errorf("DiffPanels() only in b = %q, want %q", got, tt.onlyInB)
+			}
+			if got := h.UnionPanels(a, b).Symbols(); !slices.Equal(got, tt.union) {
+				t.Errorf("UnionPanels() = %q, want %q", got, tt.union)
+			}
+			// the operands are not modified
+			if a.Len() != len(tt.a) || b.Len() != len(tt.b) {
+				t.Errorf("operands have %d and %d genes, want %d and %d", a.Len(), b.Len(), len(tt.a), len(tt.b))
+			}
+		})
+	}
+}
+
+func TestPanelIDs(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1(), testutil.FixtureMIR21(),
+		h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("TWOMIM").WithOmimID("100002|100001").Build())
+
+	tests := []struct {
+		name   string
+		genes  []string
+		omim   []string
+		entrez []string
+		ensg   []string
+	}{
+		{
+			"all ids",
+			[]string{"TP53", "BRCA1"},
+			[]string{"113705", "191170"}, []string{"672", "7157"}, []string{"ENSG00000012048", "ENSG00000141510"},
+		},
+		{
+			"missing ids are skipped",
+			[]string{"MIR21", "TWOMIM"},
+			[]string{"100001", "100002"}, []string{"406991"}, []string{"ENSG00000284190"},
+		},
+		{
+			"same gene twice",
+			[]string{"TP53", "p53"},
+			[]string{"191170"}, []string{"7157"}, []string{"ENSG00000141510"},
+		},
+		{"empty", nil, []string{}, []string{}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			panel := newPanel(t, hgnc, tt.genes...)
+			if got := panel.GetOmimIDs(); !slices.Equal(got, tt.omim) {
+				t.Errorf("GetOmimIDs() = %q, want %q", got, tt.omim)
+			}
+			if got := panel.GetEntrezIDs(); !slices.Equal(got, tt.entrez) {
+				t.Errorf("GetEntrezIDs() = %q, want %q", got, tt.entrez)
+			}
+			if got := panel.GetEnsemblIDs(); !slices.Equal(got, tt.ensg) {
+				t.Errorf("GetEnsemblIDs() = %q, want %q", got, tt.ensg)
+			}
+		})
+	}
+}
@@ -0,0 +1,734 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: proto/hgnc.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HGNCRecord is a single row of the HGNC complete set.
+//
+// Field numbers 1-50 follow the column numbers of the TSV file, each holding the
+// raw value (multi-value fields are "|"-delimited, as in the TSV file).
+// Field numbers 101-150 (100 + column number) hold the split values of multi-value fields.
+type HGNCRecord struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	HgncId                 string                 `protobuf:"bytes,1,opt,name=hgnc_id,json=hgncId,proto3" json:"hgnc_id,omitempty"`
+	Symbol                 string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name                   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	LocusGroup             string                 `protobuf:"bytes,4,opt,name=locus_group,json=locusGroup,proto3" json:"locus_group,omitempty"`
+	LocusType              string                 `protobuf:"bytes,5,opt,name=locus_type,json=locusType,proto3" json:"locus_type,omitempty"`
+	Status                 string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Location               string                 `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	LocationSortable       string                 `protobuf:"bytes,8,opt,name=location_sortable,json=locationSortable,proto3" json:"location_sortable,omitempty"`
+	AliasSymbol            string                 `protobuf:"bytes,9,opt,name=alias_symbol,json=aliasSymbol,proto3" json:"alias_symbol,omitempty"`
+	AliasName              string                 `protobuf:"bytes,10,opt,name=alias_name,json=aliasName,proto3" json:"alias_name,omitempty"`
+	PrevSymbol             string                 `protobuf:"bytes,11,opt,name=prev_symbol,json=prevSymbol,proto3" json:"prev_symbol,omitempty"`
+	PrevName               string                 `protobuf:"bytes,12,opt,name=prev_name,json=prevName,proto3" json:"prev_name,omitempty"`
+	GeneFamily             string                 `protobuf:"bytes,13,opt,name=gene_family,json=geneFamily,proto3" json:"gene_family,omitempty"`
+	GeneFamilyId           string                 `protobuf:"bytes,14,opt,name=gene_family_id,json=geneFamilyId,proto3" json:"gene_family_id,omitempty"`
+	DateApprovedReserved   string                 `protobuf:"bytes,15,opt,name=date_approved_reserved,json=dateApprovedReserved,proto3" json:"date_approved_reserved,omitempty"`
+	DateSymbolChanged      string                 `protobuf:"bytes,16,opt,name=date_symbol_changed,json=dateSymbolChanged,proto3" json:"date_symbol_changed,omitempty"`
+	DateNameChanged        string                 `protobuf:"bytes,17,opt,name=date_name_changed,json=dateNameChanged,proto3" json:"date_name_changed,omitempty"`
+	DateModified           string                 `protobuf:"bytes,18,opt,name=date_modified,json=dateModified,proto3" json:"date_modified,omitempty"`
+	EntrezId               string                 `protobuf:"bytes,19,opt,name=entrez_id,json=entrezId,proto3" json:"entrez_id,omitempty"`
+	EnsemblGeneId          string                 `protobuf:"bytes,20,opt,name=ensembl_gene_id,json=ensemblGeneId,proto3" json:"ensembl_gene_id,omitempty"`
+	VegaId                 string                 `protobuf:"bytes,21,opt,name=vega_id,json=vegaId,proto3" json:"vega_id,omitempty"`
+	UcscId                 string                 `protobuf:"bytes,22,opt,name=ucsc_id,json=ucscId,proto3" json:"ucsc_id,omitempty"`
+	Ena                    string                 `protobuf:"bytes,23,opt,name=ena,proto3" json:"ena,omitempty"`
+	RefseqAccession        string                 `protobuf:"bytes,24,opt,name=refseq_accession,json=refseqAccession,proto3" json:"refseq_accession,omitempty"`
+	CcdsId                 string                 `protobuf:"bytes,25,opt,name=ccds_id,json=ccdsId,proto3" json:"ccds_id,omitempty"`
+	UniprotIds             string                 `protobuf:"bytes,26,opt,name=uniprot_ids,json=uniprotIds,proto3" json:"uniprot_ids,omitempty"`
+	PubmedId               string                 `protobuf:"bytes,27,opt,name=pubmed_id,json=pubmedId,proto3" json:"pubmed_id,omitempty"`
+	MgdId                  string                 `protobuf:"bytes,28,opt,name=mgd_id,json=mgdId,proto3" json:"mgd_id,omitempty"`
+	RgdId                  string                 `protobuf:"bytes,29,opt,name=rgd_id,json=rgdId,proto3" json:"rgd_id,omitempty"`
+	Lsdb                   string                 `protobuf:"bytes,30,opt,name=lsdb,proto3" json:"lsdb,omitempty"`
+	Cosmic                 string                 `protobuf:"bytes,31,opt,name=cosmic,proto3" json:"cosmic,omitempty"`
+	OmimId                 string                 `protobuf:"bytes,32,opt,name=omim_id,json=omimId,proto3" json:"omim_id,omitempty"`
+	Mirbase                string                 `protobuf:"bytes,33,opt,name=mirbase,proto3" json:"mirbase,omitempty"`
+	Homeodb                string                 `protobuf:"bytes,34,opt,name=homeodb,proto3" json:"homeodb,omitempty"`
+	Snornabase             string                 `protobuf:"bytes,35,opt,name=snornabase,proto3" json:"snornabase,omitempty"`
+	BioparadigmsSlc        string                 `protobuf:"bytes,36,opt,name=bioparadigms_slc,json=bioparadigmsSlc,proto3" json:"bioparadigms_slc,omitempty"`
+	Orphanet               string                 `protobuf:"bytes,37,opt,name=orphanet,proto3" json:"orphanet,omitempty"`
+	PseudogeneOrg          string                 `protobuf:"bytes,38,opt,name=pseudogene_org,json=pseudogeneOrg,proto3" json:"pseudogene_org,omitempty"`
+	HordeId                string                 `protobuf:"bytes,39,opt,name=horde_id,json=hordeId,proto3" json:"horde_id,omitempty"`
+	Merops                 string                 `protobuf:"bytes,40,opt,name=merops,proto3" json:"merops,omitempty"`
+	Imgt                   string                 `protobuf:"bytes,41,opt,name=imgt,proto3" json:"imgt,omitempty"`
+	Iuphar                 string                 `protobuf:"bytes,42,opt,name=iuphar,proto3" json:"iuphar,omitempty"`
+	KznfGeneCatalog        string                 `protobuf:"bytes,43,opt,name=kznf_gene_catalog,json=kznfGeneCatalog,proto3" json:"kznf_gene_catalog,omitempty"`
+	MamitTrnadb            string                 `protobuf:"bytes,44,opt,name=mamit_trnadb,json=mamitTrnadb,proto3" json:"mamit_trnadb,omitempty"`
+	Cd                     string                 `protobuf:"bytes,45,opt,name=cd,proto3" json:"cd,omitempty"`
+	Lncrnadb               string                 `protobuf:"bytes,46,opt,name=lncrnadb,proto3" json:"lncrnadb,omitempty"`
+	EnzymeId               string                 `protobuf:"bytes,47,opt,name=enzyme_id,json=enzymeId,proto3" json:"enzyme_id,omitempty"`
+	IntermediateFilamentDb string                 `protobuf:"bytes,48,opt,name=intermediate_filament_db,json=intermediateFilamentDb,proto3" json:"intermediate_filament_db,omitempty"`
+	Agr                    string                 `protobuf:"bytes,49,opt,name=agr,proto3" json:"agr,omitempty"`
+	ManeSelect             string                 `protobuf:"bytes,50,opt,name=mane_select,json=maneSelect,proto3" json:"mane_select,omitempty"`
+	AliasSymbolValues      []string               `protobuf:"bytes,109,rep,name=alias_symbol_values,json=aliasSymbolValues,proto3" json:"alias_symbol_values,omitempty"`
+	AliasNameValues        []string               `protobuf:"bytes,110,rep,name=alias_name_values,json=aliasNameValues,proto3" json:"alias_name_values,omitempty"`
+	PrevSymbolValues       []string               `protobuf:"bytes,111,rep,name=prev_symbol_values,json=prevSymbolValues,proto3" json:"prev_symbol_values,omitempty"`
+	PrevNameValues         []string               `protobuf:"bytes,112,rep,name=prev_name_values,json=prevNameValues,proto3" json:"prev_name_values,omitempty"`
+	GeneFamilyValues       []string               `protobuf:"bytes,113,rep,name=gene_family_values,json=geneFamilyValues,proto3" json:"gene_family_values,omitempty"`
+	GeneFamilyIdValues     []string               `protobuf:"bytes,114,rep,name=gene_family_id_values,json=geneFamilyIdValues,proto3" json:"gene_family_id_values,omitempty"`
+	EnaValues              []string               `protobuf:"bytes,123,rep,name=ena_values,json=enaValues,proto3" json:"ena_values,omitempty"`
+	RefseqAccessionValues  []string               `protobuf:"bytes,124,rep,name=refseq_accession_values,json=refseqAccessionValues,proto3" json:"refseq_accession_values,omitempty"`
+	CcdsIdValues           []string               `protobuf:"bytes,125,rep,name=ccds_id_values,json=ccdsIdValues,proto3" json:"ccds_id_values,omitempty"`
+	UniprotIdsValues       []string               `protobuf:"bytes,126,rep,name=uniprot_ids_values,json=uniprotIdsValues,proto3" json:"uniprot_ids_values,omitempty"`
+	PubmedIdValues         []string               `protobuf:"bytes,127,rep,name=pubmed_id_values,json=pubmedIdValues,proto3" json:"pubmed_id_values,omitempty"`
+	MgdIdValues            []string               `protobuf:"bytes,128,rep,name=mgd_id_values,json=mgdIdValues,proto3" json:"mgd_id_values,omitempty"`
+	RgdIdValues            []string               `protobuf:"bytes,129,rep,name=rgd_id_values,json=rgdIdValues,proto3" json:"rgd_id_values,omitempty"`
+	LsdbValues             []string               `protobuf:"bytes,130,rep,name=lsdb_values,json=lsdbValues,proto3" json:"lsdb_values,omitempty"`
+	OmimIdValues           []string               `protobuf:"bytes,132,rep,name=omim_id_values,json=omimIdValues,proto3" json:"omim_id_values,omitempty"`
+	EnzymeIdValues         []string               `protobuf:"bytes,147,rep,name=enzyme_id_values,json=enzymeIdValues,proto3" json:"enzyme_id_values,omitempty"`
+	ManeSelectValues       []string               `protobuf:"bytes,150,rep,name=mane_select_values,json=maneSelectValues,proto3" json:"mane_select_values,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *HGNCRecord) Reset() {
+	*x = HGNCRecord{}
+	mi := &file_proto_hgnc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HGNCRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HGNCRecord) ProtoMessage() {}
+
+func (x *HGNCRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_hgnc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HGNCRecord.ProtoReflect.Descriptor instead.
+func (*HGNCRecord) Descriptor() ([]byte, []int) {
+	return file_proto_hgnc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HGNCRecord) GetHgncId() string {
+	if x != nil {
+		return x.HgncId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetLocusGroup() string {
+	if x != nil {
+		return x.LocusGroup
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetLocusType() string {
+	if x != nil {
+		return x.LocusType
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetLocationSortable() string {
+	if x != nil {
+		return x.LocationSortable
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetAliasSymbol() string {
+	if x != nil {
+		return x.AliasSymbol
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetAliasName() string {
+	if x != nil {
+		return x.AliasName
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetPrevSymbol() string {
+	if x != nil {
+		return x.PrevSymbol
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetPrevName() string {
+	if x != nil {
+		return x.PrevName
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetGeneFamily() string {
+	if x != nil {
+		return x.GeneFamily
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetGeneFamilyId() string {
+	if x != nil {
+		return x.GeneFamilyId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetDateApprovedReserved() string {
+	if x != nil {
+		return x.DateApprovedReserved
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetDateSymbolChanged() string {
+	if x != nil {
+		return x.DateSymbolChanged
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetDateNameChanged() string {
+	if x != nil {
+		return x.DateNameChanged
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetDateModified() string {
+	if x != nil {
+		return x.DateModified
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetEntrezId() string {
+	if x != nil {
+		return x.EntrezId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetEnsemblGeneId() string {
+	if x != nil {
+		return x.EnsemblGeneId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetVegaId() string {
+	if x != nil {
+		return x.VegaId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetUcscId() string {
+	if x != nil {
+		return x.UcscId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetEna() string {
+	if x != nil {
+		return x.Ena
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetRefseqAccession() string {
+	if x != nil {
+		return x.RefseqAccession
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetCcdsId() string {
+	if x != nil {
+		return x.CcdsId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetUniprotIds() string {
+	if x != nil {
+		return x.UniprotIds
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetPubmedId() string {
+	if x != nil {
+		return x.PubmedId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetMgdId() string {
+	if x != nil {
+		return x.MgdId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetRgdId() string {
+	if x != nil {
+		return x.RgdId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetLsdb() string {
+	if x != nil {
+		return x.Lsdb
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetCosmic() string {
+	if x != nil {
+		return x.Cosmic
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetOmimId() string {
+	if x != nil {
+		return x.OmimId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetMirbase() string {
+	if x != nil {
+		return x.Mirbase
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetHomeodb() string {
+	if x != nil {
+		return x.Homeodb
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetSnornabase() string {
+	if x != nil {
+		return x.Snornabase
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetBioparadigmsSlc() string {
+	if x != nil {
+		return x.BioparadigmsSlc
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetOrphanet() string {
+	if x != nil {
+		return x.Orphanet
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetPseudogeneOrg() string {
+	if x != nil {
+		return x.PseudogeneOrg
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetHordeId() string {
+	if x != nil {
+		return x.HordeId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetMerops() string {
+	if x != nil {
+		return x.Merops
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetImgt() string {
+	if x != nil {
+		return x.Imgt
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetIuphar() string {
+	if x != nil {
+		return x.Iuphar
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetKznfGeneCatalog() string {
+	if x != nil {
+		return x.KznfGeneCatalog
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetMamitTrnadb() string {
+	if x != nil {
+		return x.MamitTrnadb
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetCd() string {
+	if x != nil {
+		return x.Cd
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetLncrnadb() string {
+	if x != nil {
+		return x.Lncrnadb
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetEnzymeId() string {
+	if x != nil {
+		return x.EnzymeId
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetIntermediateFilamentDb() string {
+	if x != nil {
+		return x.IntermediateFilamentDb
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetAgr() string {
+	if x != nil {
+		return x.Agr
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetManeSelect() string {
+	if x != nil {
+		return x.ManeSelect
+	}
+	return ""
+}
+
+func (x *HGNCRecord) GetAliasSymbolValues() []string {
+	if x != nil {
+		return x.AliasSymbolValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetAliasNameValues() []string {
+	if x != nil {
+		return x.AliasNameValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetPrevSymbolValues() []string {
+	if x != nil {
+		return x.PrevSymbolValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetPrevNameValues() []string {
+	if x != nil {
+		return x.PrevNameValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetGeneFamilyValues() []string {
+	if x != nil {
+		return x.GeneFamilyValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetGeneFamilyIdValues() []string {
+	if x != nil {
+		return x.GeneFamilyIdValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetEnaValues() []string {
+	if x != nil {
+		return x.EnaValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetRefseqAccessionValues() []string {
+	if x != nil {
+		return x.RefseqAccessionValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetCcdsIdValues() []string {
+	if x != nil {
+		return x.CcdsIdValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetUniprotIdsValues() []string {
+	if x != nil {
+		return x.UniprotIdsValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetPubmedIdValues() []string {
+	if x != nil {
+		return x.PubmedIdValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetMgdIdValues() []string {
+	if x != nil {
+		return x.MgdIdValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetRgdIdValues() []string {
+	if x != nil {
+		return x.RgdIdValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetLsdbValues() []string {
+	if x != nil {
+		return x.LsdbValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetOmimIdValues() []string {
+	if x != nil {
+		return x.OmimIdValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetEnzymeIdValues() []string {
+	if x != nil {
+		return x.EnzymeIdValues
+	}
+	return nil
+}
+
+func (x *HGNCRecord) GetManeSelectValues() []string {
+	if x != nil {
+		return x.ManeSelectValues
+	}
+	return nil
+}
+
+var File_proto_hgnc_proto protoreflect.FileDescriptor
+
+const file_proto_hgnc_proto_rawDesc = "" +
+	"\n" +
+	"\x10proto/hgnc.proto\x12\x04hgnc\"\xeb\x11\n" +
+	"\n" +
+	"HGNCRecord\x12\x17\n" +
+	"\ahgnc_id\x18\x01 \x01(\tR\x06hgncId\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vlocus_group\x18\x04 \x01(\tR\n" +
+	"locusGroup\x12\x1d\n" +
+	"\n" +
+	"locus_type\x18\x05 \x01(\tR\tlocusType\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1a\n" +
+	"\blocation\x18\a \x01(\tR\blocation\x12+\n" +
+	"\x11location_sortable\x18\b \x01(\tR\x10locationSortable\x12!\n" +
+	"\falias_symbol\x18\t \x01(\tR\valiasSymbol\x12\x1d\n" +
+	"\n" +
+	"alias_name\x18\n" +
+	" \x01(\tR\taliasName\x12\x1f\n" +
+	"\vprev_symbol\x18\v \x01(\tR\n" +
+	"prevSymbol\x12\x1b\n" +
+	"\tprev_name\x18\f \x01(\tR\bprevName\x12\x1f\n" +
+	"\vgene_family\x18\r \x01(\tR\n" +
+	"geneFamily\x12$\n" +
+	"\x0egene_family_id\x18\x0e \x01(\tR\fgeneFamilyId\x124\n" +
+	"\x16date_approved_reserved\x18\x0f \x01(\tR\x14dateApprovedReserved\x12.\n" +
+	"\x13date_symbol_changed\x18\x10 \x01(\tR\x11dateSymbolChanged\x12*\n" +
+	"\x11date_name_changed\x18\x11 \x01(\tR\x0fdateNameChanged\x12#\n" +
+	"\rdate_modified\x18\x12 \x01(\tR\fdateModified\x12\x1b\n" +
+	"\tentrez_id\x18\x13 \x01(\tR\bentrezId\x12&\n" +
+	"\x0fensembl_gene_id\x18\x14 \x01(\tR\rensemblGeneId\x12\x17\n" +
+	"\avega_id\x18\x15 \x01(\tR\x06vegaId\x12\x17\n" +
+	"\aucsc_id\x18\x16 \x01(\tR\x06ucscId\x12\x10\n" +
+	"\x03ena\x18\x17 \x01(\tR\x03ena\x12)\n" +
+	"\x10refseq_accession\x18\x18 \x01(\tR\x0frefseqAccession\x12\x17\n" +
+	"\accds_id\x18\x19 \x01(\tR\x06ccdsId\x12\x1f\n" +
+	"\vuniprot_ids\x18\x1a \x01(\tR\n" +
+	"uniprotIds\x12\x1b\n" +
+	"\tpubmed_id\x18\x1b \x01(\tR\bpubmedId\x12\x15\n" +
+	"\x06mgd_id\x18\x1c \x01(\tR\x05mgdId\x12\x15\n" +
+	"\x06rgd_id\x18\x1d \x01(\tR\x05rgdId\x12\x12\n" +
+	"\x04lsdb\x18\x1e \x01(\tR\x04lsdb\x12\x16\n" +
+	"\x06cosmic\x18\x1f \x01(\tR\x06cosmic\x12\x17\n" +
+	"\aomim_id\x18  \x01(\tR\x06omimId\x12\x18\n" +
+	"\amirbase\x18! \x01(\tR\amirbase\x12\x18\n" +
+	"\ahomeodb\x18\" \x01(\tR\ahomeodb\x12\x1e\n" +
+	"\n" +
+	"snornabase\x18# \x01(\tR\n" +
+	"snornabase\x12)\n" +
+	"\x10bioparadigms_slc\x18$ \x01(\tR\x0fbioparadigmsSlc\x12\x1a\n" +
+	"\borphanet\x18% \x01(\tR\borphanet\x12%\n" +
+	"\x0epseudogene_org\x18& \x01(\tR\rpseudogeneOrg\x12\x19\n" +
+	"\bhorde_id\x18' \x01(\tR\ahordeId\x12\x16\n" +
+	"\x06merops\x18( \x01(\tR\x06merops\x12\x12\n" +
+	"\x04imgt\x18) \x01(\tR\x04imgt\x12\x16\n" +
+	"\x06iuphar\x18* \x01(\tR\x06iuphar\x12*\n" +
+	"\x11kznf_gene_catalog\x18+ \x01(\tR\x0fkznfGeneCatalog\x12!\n" +
+	"\fmamit_trnadb\x18, \x01(\tR\vmamitTrnadb\x12\x0e\n" +
+	"\x02cd\x18- \x01(\tR\x02cd\x12\x1a\n" +
+	"\blncrnadb\x18. \x01(\tR\blncrnadb\x12\x1b\n" +
+	"\tenzyme_id\x18/ \x01(\tR\benzymeId\x128\n" +
+	"\x18intermediate_filament_db\x180 \x01(\tR\x16intermediateFilamentDb\x12\x10\n" +
+	"\x03agr\x181 \x01(\tR\x03agr\x12\x1f\n" +
+	"\vmane_select\x182 \x01(\tR\n" +
+	"maneSelect\x12.\n" +
+	"\x13alias_symbol_values\x18m \x03(\tR\x11aliasSymbolValues\x12*\n" +
+	"\x11alias_name_values\x18n \x03(\tR\x0faliasNameValues\x12,\n" +
+	"\x12prev_symbol_values\x18o \x03(\tR\x10prevSymbolValues\x12(\n" +
+	"\x10prev_name_values\x18p \x03(\tR\x0eprevNameValues\x12,\n" +
+	"\x12gene_family_values\x18q \x03(\tR\x10geneFamilyValues\x121\n" +
+	"\x15gene_family_id_values\x18r \x03(\tR\x12geneFamilyIdValues\x12\x1d\n" +
+	"\n" +
+	"ena_values\x18{ \x03(\tR\tenaValues\x126\n" +
+	"\x17refseq_accession_values\x18| \x03(\tR\x15refseqAccessionValues\x12$\n" +
+	"\x0eccds_id_values\x18} \x03(\tR\fccdsIdValues\x12,\n" +
+	"\x12uniprot_ids_values\x18~ \x03(\tR\x10uniprotIdsValues\x12(\n" +
+	"\x10pubmed_id_values\x18\x7f \x03(\tR\x0epubmedIdValues\x12#\n" +
+	"\rmgd_id_values\x18\x80\x01 \x03(\tR\vmgdIdValues\x12#\n" +
+	"\rrgd_id_values\x18\x81\x01 \x03(\tR\vrgdIdValues\x12 \n" +
+	"\vlsdb_values\x18\x82\x01 \x03(\tR\n" +
+	"lsdbValues\x12%\n" +
+	"\x0eomim_id_values\x18\x84\x01 \x03(\tR\fomimIdValues\x12)\n" +
+	"\x10enzyme_id_values\x18\x93\x01 \x03(\tR\x0eenzymeIdValues\x12-\n" +
+	"\x12mane_select_values\x18\x96\x01 \x03(\tR\x10maneSelectValuesB!Z\x1fgithub.com/viktorxia/hgnc-go/pbb\x06proto3"
+
+var (
+	file_proto_hgnc_proto_rawDescOnce sync.Once
+	file_proto_hgnc_proto_rawDescData []byte
+)
+
+func file_proto_hgnc_proto_rawDescGZIP() []byte {
+	file_proto_hgnc_proto_rawDescOnce.Do(func() {
+		file_proto_hgnc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_hgnc_proto_rawDesc), len(file_proto_hgnc_proto_rawDesc)))
+	})
+	return file_proto_hgnc_proto_rawDescData
+}
+
+var file_proto_hgnc_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_hgnc_proto_goTypes = []any{
+	(*HGNCRecord)(nil), // 0: hgnc.HGNCRecord
+}
+var file_proto_hgnc_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_hgnc_proto_init() }
+func file_proto_hgnc_proto_init() {
+	if File_proto_hgnc_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_hgnc_proto_rawDesc), len(file_proto_hgnc_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_hgnc_proto_goTypes,
+		DependencyIndexes: file_proto_hgnc_proto_depIdxs,
+		MessageInfos:      file_proto_hgnc_proto_msgTypes,
+	}.Build()
+	File_proto_hgnc_proto = out.File
+	file_proto_hgnc_proto_goTypes = nil
+	file_proto_hgnc_proto_depIdxs = nil
+}
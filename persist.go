@@ -0,0 +1,142 @@
+package hgnc_go
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+/*
+Binary format written by Save():
+
+	magic (6 bytes, "HGNCGO") | version (1 byte) | gob encoded savedHGNC
+
+LoadSaved() checks the magic and version before decoding, so a stale or
+foreign file results in a descriptive error instead of garbage data.
+Bump savedVersion whenever savedHGNC changes in an incompatible way.
+*/
+
+var savedMagic = []byte("HGNCGO")
+
+// savedVersion history:
+//
+//	1: initial format
+//	2: multi-value fields are cached per value, CCDS IDs without version suffix
+const savedVersion byte = 2
+
+// savedHGNC is the serializable representation of HGNC.
+type savedHGNC struct {
+	Records        []map[Field]string
+	GeneSymbolMap  map[string]string
+	StdHgncSymbols []string
+	Caches         map[Field]Cache
+	AutoNormSymbol bool
+	ReleaseDate    time.Time
+	SourceURL      string
+}
+
+// Save serializes the whole HGNC database (records, caches and symbol maps) to w.
+// Use LoadSaved to restore it without parsing the TSV file again.
+func (h *HGNC) Save(w io.Writer) error {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	s := savedHGNC{
+		Records:        make([]map[Field]string, len(h.records)),
+		GeneSymbolMap:  h.geneSymbolMap,
+		StdHgncSymbols: make([]string, 0, len(h.stdHgncSymbols)),
+		Caches:         make(map[Field]Cache, len(h.caches)),
+		AutoNormSymbol: h.autoNormSymbol,
+		ReleaseDate:    h.releaseDate,
+		SourceURL:      h.sourceURL,
+	}
+	for i, record := range h.records {
+		s.Records[i] = record.data
+	}
+	for sym := range h.stdHgncSymbols {
+		s.StdHgncSymbols = append(s.StdHgncSymbols, sym)
+	}
+	for field, cache := range h.caches {
+		// lazy caches not built yet are registered again by LoadSaved
+		if cache != nil {
+			s.Caches[field] = cache
+		}
+	}
+
+	if _, err := w.Write(savedMagic); err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte{savedVersion}); err != nil {
+		return err
+	}
+	return gob.NewEncoder(w).Encode(&s)
+}
+
+// LoadSaved restores an HGNC database written by Save.
+func LoadSaved(r io.Reader) (*HGNC, error) {
+
+	// check header
+	header := make([]byte, len(savedMagic)+1)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed reading saved HGNC header: %w", err)
+	}
+	if !bytes.Equal(header[:len(savedMagic)], savedMagic) {
+		return nil, errors.New("not a saved HGNC file (bad magic number)")
+	}
+	if version := header[len(savedMagic)]; version != savedVersion {
+		return nil, fmt.Errorf(
+			"incompatible saved HGNC file version %d (expected %d), please re-create it",
+			version, savedVersion,
+		)
+	}
+
+	// decode
+	var s savedHGNC
+	if err := gob.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed decoding saved HGNC: %w", err)
+	}
+
+	h := &HGNC{
+		records:        make([]*Record, len(s.Records)),
+		geneSymbolMap:  s.GeneSymbolMap,
+		stdHgncSymbols: make(map[string]struct{}, len(s.StdHgncSymbols)),
+		caches:         s.Caches,
+		autoNormSymbol: s.AutoNormSymbol,
+		indexOnce:      make(map[Field]*sync.Once),
+		ciCaches:       make(map[Field]Cache),
+		releaseDate:    s.ReleaseDate,
+		sourceURL:      s.SourceURL,
+	}
+	for i, data := range s.Records {
+		if data == nil {
+			data = make(map[Field]string)
+		}
+		h.records[i] = &Record{data: data}
+	}
+	for _, sym := range s.StdHgncSymbols {
+		h.stdHgncSymbols[sym] = struct{}{}
+	}
+	if h.geneSymbolMap == nil {
+		h.geneSymbolMap = make(map[string]string)
+	}
+	if h.caches == nil {
+		h.caches = make(map[Field]Cache)
+	}
+	for field, cache := range h.caches {
+		if cache == nil {
+			h.caches[field] = make(Cache)
+		}
+	}
+	h.registerLazyIndexes()
+
+	return h, nil
+}
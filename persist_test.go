@@ -0,0 +1,157 @@
+package hgnc_go_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestSaveLoadSaved(t *testing.T) {
+
+	var buf bytes.Buffer
+	if err := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1()).Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	saved := buf.Bytes()
+
+	tests := []struct {
+		name    string
+		data    func() []byte
+		wantErr string
+	}{
+		{"round trip", func() []byte { return saved }, ""},
+		{"bad magic", func() []byte { return append([]byte("XXXXXX"), saved[6:]...) }, "bad magic number"},
+		{"version 1", func() []byte {
+			// before multi-value fields were cached per value
+			old := bytes.Clone(saved)
+			old[6] = 1
+			return old
+		}, "incompatible saved HGNC file version 1"},
+		{"truncated", func() []byte { return saved[:3] }, "failed reading saved HGNC header"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hgnc, err := h.LoadSaved(bytes.NewReader(tt.data()))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadSaved() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, q := range []struct {
+				value string
+				field h.Field
+			}{
+				{"p53", h.FIELD_SYMBOL},
+				{"CCDS45605", h.FIELD_CCDS_ID},
+				{"MGI:104537", h.FIELD_MGD_ID},
+			} {
+				if got := len(hgnc.Fetch(q.value, q.field)); got != 1 {
+					t.Errorf("Fetch(%q, %s) returned %d records, want 1", q.value, q.field, got)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadTsvWithIndexVersion(t *testing.T) {
+
+	dir := t.TempDir()
+	tsvPath := filepath.Join(dir, "hgnc.tsv")
+	indexPath := filepath.Join(dir, "hgnc.idx")
+
+	var tsv bytes.Buffer
+	if err := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1()).ExportAllToTSV(&tsv, h.AllFields()); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(tsvPath, tsv.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	hgnc, err := h.LoadTsv(tsvPath, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hgnc.SaveIndex(indexPath); err != nil {
+		t.Fatal(err)
+	}
+
+	// an index file of version 1 has stale cache keys, it must be rebuilt, not used
+	index, err := os.ReadFile(indexPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if index[6] != 2 {
+		t.Fatalf("index file version = %d, want 2", index[6])
+	}
+	index[6] = 1
+	if err := os.WriteFile(indexPath, index, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	hgnc, err = h.LoadTsvWithIndex(tsvPath, indexPath, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(hgnc.Fetch("CCDS45605", h.FIELD_CCDS_ID)); got != 1 {
+		t.Errorf("Fetch(CCDS45605) returned %d records, want 1", got)
+	}
+}
+
+func TestSaveIndex(t *testing.T) {
+
+	var tsv bytes.Buffer
+	if err := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1()).ExportAllToTSV(&tsv, h.AllFields()); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(hgnc *h.HGNC)
+		wantErr string
+	}{
+		{"unmodified", func(*h.HGNC) {}, ""},
+		{"index rebuilt", func(hgnc *h.HGNC) { hgnc.RebuildIndexes() }, ""},
+		{"record added", func(hgnc *h.HGNC) { hgnc.AddRecord(testutil.FixtureMIR21()) }, "modified since loading"},
+		{"record updated", func(hgnc *h.HGNC) {
+			hgnc.UpdateRecord("HGNC:11998", h.NewRecordBuilder().WithHgncID("HGNC:11998").WithSymbol("TP53X").Build())
+		}, "modified since loading"},
+		{"record removed", func(hgnc *h.HGNC) { hgnc.RemoveRecord("HGNC:1100") }, "modified since loading"},
+		{"nothing removed", func(hgnc *h.HGNC) { hgnc.RemoveRecord("HGNC:0") }, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			tsvPath := filepath.Join(dir, "hgnc.tsv")
+			if err := os.WriteFile(tsvPath, tsv.Bytes(), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			hgnc, err := h.LoadTsv(tsvPath, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.mutate(hgnc)
+
+			err = hgnc.SaveIndex(filepath.Join(dir, "hgnc.idx"))
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("SaveIndex() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("SaveIndex() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("not loaded from a file", func(t *testing.T) {
+		if err := testutil.NewMockHGNC(testutil.FixtureTP53()).SaveIndex(filepath.Join(t.TempDir(), "hgnc.idx")); err == nil {
+			t.Error("SaveIndex() of a database not loaded from a file succeeded")
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package hgnc_go
+
+import (
+	"strings"
+
+	"github.com/viktorxia/hgnc-go/pb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+/*
+Record <-> pb.HGNCRecord conversion (see proto/hgnc.proto).
+
+Proto field names are field names with "." and "-" replaced by "_"
+(e.g. "pseudogene.org" -> "pseudogene_org"). Multi-value fields have an
+extra repeated "<name>_values" field holding the split values.
+
+Regenerate pb/ after changing proto/hgnc.proto:
+
+	protoc --go_out=. --go_opt=paths=source_relative proto/hgnc.proto && mv proto/hgnc.pb.go pb/
+*/
+
+// protoFieldName converts a Field to the name of its proto field.
+func protoFieldName(f Field) protoreflect.Name {
+	return protoreflect.Name(strings.NewReplacer(".", "_", "-", "_").Replace(string(f)))
+}
+
+// ToProto converts the Record to a protobuf message.
+func (r *Record) ToProto() *pb.HGNCRecord {
+
+	p := &pb.HGNCRecord{}
+	m := p.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	for field, value := range r.data {
+		if value == "" {
+			continue
+		}
+		name := protoFieldName(field)
+		if fd := fields.ByName(name); fd != nil {
+			m.Set(fd, protoreflect.ValueOfString(value))
+		}
+		if fd := fields.ByName(name + "_values"); fd != nil && IsMultiValueField(field) {
+			list := m.Mutable(fd).List()
+			for _, v := range SplitMultiValue(value) {
+				list.Append(protoreflect.ValueOfString(v))
+			}
+		}
+	}
+
+	return p
+}
+
+// FromProto converts a protobuf message to a Record. For multi-value fields the
+// raw string is used if set, otherwise the repeated values are joined with "|".
+func FromProto(p *pb.HGNCRecord) *Record {
+
+	record := &Record{data: make(map[Field]string)}
+	if p == nil {
+		return record
+	}
+
+	m := p.ProtoReflect()
+	fields := m.Descriptor().Fields()
+
+	for field := range fieldDesc {
+		name := protoFieldName(field)
+		value := ""
+		if fd := fields.ByName(name); fd != nil {
+			value = m.Get(fd).String()
+		}
+		if fd := fields.ByName(name + "_values"); value == "" && fd != nil {
+			list := m.Get(fd).List()
+			values := make([]string, 0, list.Len())
+			for i := 0; i < list.Len(); i++ {
+				values = append(values, list.Get(i).String())
+			}
+			value = strings.Join(values, multiValueDelimiter)
+		}
+		record.data[field] = value
+	}
+
+	return record
+}
@@ -0,0 +1,73 @@
+package hgnc_go_test
+
+import (
+	"slices"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/pb"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestToProto(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		record     *h.Record
+		wantSymbol string
+		wantAlias  []string
+		wantCcds   []string
+	}{
+		{"TP53", testutil.FixtureTP53(), "TP53", []string{"p53", "LFS1"}, []string{"CCDS11118", "CCDS45605"}},
+		{"MIR21", testutil.FixtureMIR21(), "MIR21", []string{"hsa-mir-21"}, nil},
+		{"empty", h.NewRecordBuilder().Build(), "", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.record.ToProto()
+			if got := p.GetSymbol(); got != tt.wantSymbol {
+				t.Errorf("symbol = %q, want %q", got, tt.wantSymbol)
+			}
+			if got := p.GetAliasSymbolValues(); !slices.Equal(got, tt.wantAlias) {
+				t.Errorf("alias_symbol_values = %q, want %q", got, tt.wantAlias)
+			}
+			if got := p.GetCcdsIdValues(); !slices.Equal(got, tt.wantCcds) {
+				t.Errorf("ccds_id_values = %q, want %q", got, tt.wantCcds)
+			}
+
+			// round trip
+			back := h.FromProto(p)
+			if changes := tt.record.CompareFields(back); len(changes) > 0 {
+				t.Errorf("FromProto(ToProto()) differs: %+v", changes)
+			}
+		})
+	}
+}
+
+func TestFromProto(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		p         *pb.HGNCRecord
+		wantAlias string
+	}{
+		{"nil", nil, ""},
+		{"raw value", &pb.HGNCRecord{Symbol: "TP53", AliasSymbol: "p53|LFS1"}, "p53|LFS1"},
+		{"repeated values only", &pb.HGNCRecord{Symbol: "TP53", AliasSymbolValues: []string{"p53", "LFS1"}}, "p53|LFS1"},
+		{"raw value wins", &pb.HGNCRecord{Symbol: "TP53", AliasSymbol: "p53", AliasSymbolValues: []string{"LFS1"}}, "p53"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := h.FromProto(tt.p)
+			if record == nil {
+				t.Fatal("FromProto returned nil")
+			}
+			if got := record.AliasSymbol(); got != tt.wantAlias {
+				t.Errorf("alias_symbol = %q, want %q", got, tt.wantAlias)
+			}
+			if got, want := record.Symbol(), tt.p.GetSymbol(); got != want {
+				t.Errorf("symbol = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package hgnc_go
+
+import (
+	"sort"
+	"strconv"
+)
+
+// GetAllPublications returns unique PubMed IDs of all genes (in any supported id system),
+// sorted numerically. Genes not found are skipped.
+func (h *HGNC) GetAllPublications(genes []string) []string {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	seen := make(map[string]struct{})
+	for _, gene := range genes {
+		records, err := h.ResolveGeneAll(gene)
+		if err != nil {
+			continue
+		}
+		for _, record := range records {
+			for _, pmid := range SplitMultiValue(record.data[FIELD_PUBMED_ID]) {
+				seen[pmid] = struct{}{}
+			}
+		}
+	}
+
+	results := make([]string, 0, len(seen))
+	for pmid := range seen {
+		results = append(results, pmid)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return lessPubmedID(results[i], results[j])
+	})
+	return results
+}
+
+// lessPubmedID compares PubMed IDs as integers, non-numeric IDs sort last by string.
+func lessPubmedID(a, b string) bool {
+	x, errX := strconv.ParseUint(a, 10, 64)
+	y, errY := strconv.ParseUint(b, 10, 64)
+	switch {
+	case errX == nil && errY == nil:
+		return x < y
+	case errX == nil:
+		return true
+	case errY == nil:
+		return false
+	default:
+		return a < b
+	}
+}
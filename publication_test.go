@@ -0,0 +1,36 @@
+package hgnc_go_test
+
+import (
+	"slices"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestGetAllPublications(t *testing.T) {
+
+	other := h.NewRecordBuilder().WithHgncID("HGNC:5").WithSymbol("OTHER").With(h.FIELD_PUBMED_ID, "99|abc|8091231").Build()
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1(), testutil.FixtureMIR21(), other)
+
+	tests := []struct {
+		name  string
+		genes []string
+		want  []string
+	}{
+		{"one gene", []string{"TP53"}, []string{"3456488", "6396087"}},
+		{"id systems", []string{"HGNC:11998", "672"}, []string{"3456488", "6396087", "8091231"}},
+		{"deduplicated and numeric order", []string{"BRCA1", "OTHER", "p53"}, []string{"99", "3456488", "6396087", "8091231", "abc"}},
+		{"no publications", []string{"MIR21"}, []string{}},
+		{"not found", []string{"NOPE", ""}, []string{}},
+		{"empty", nil, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hgnc.GetAllPublications(tt.genes)
+			if got == nil || !slices.Equal(got, tt.want) {
+				t.Errorf("GetAllPublications(%q) = %q, want %q", tt.genes, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package hgnc_go
+
+import "strings"
+
+// QueryBuilder composes multiple conditions on records. All conditions are
+// combined with AND and evaluated in a single pass over HGNC records.
+//
+//	records := hgnc.Query().
+//		ByLocusGroup("protein-coding gene").
+//		ByStatus("Approved").
+//		HasField(FIELD_ENSEMBL_GENE_ID).
+//		Limit(100).
+//		Execute()
+type QueryBuilder struct {
+	h          *HGNC
+	conditions []func(*Record) bool
+	limit      int // <= 0 means no limit
+}
+
+// Query returns a new QueryBuilder on the HGNC database.
+func (h *HGNC) Query() *QueryBuilder {
+	if h == nil {
+		panic("HGNC is nil")
+	}
+	return &QueryBuilder{h: h}
+}
+
+// Where adds a custom condition.
+func (q *QueryBuilder) Where(predicate func(*Record) bool) *QueryBuilder {
+	q.conditions = append(q.conditions, predicate)
+	return q
+}
+
+// ByLocusGroup keeps records whose locus group equals locusGroup.
+func (q *QueryBuilder) ByLocusGroup(locusGroup string) *QueryBuilder {
+	return q.FieldEquals(FIELD_LOCUS_GROUP, locusGroup)
+}
+
+// ByLocusType keeps records whose locus type equals locusType.
+func (q *QueryBuilder) ByLocusType(locusType string) *QueryBuilder {
+	return q.FieldEquals(FIELD_LOCUS_TYPE, locusType)
+}
+
+// ByStatus keeps records whose status equals status.
+func (q *QueryBuilder) ByStatus(status string) *QueryBuilder {
+	return q.FieldEquals(FIELD_STATUS, status)
+}
+
+// HasField keeps records where field is not empty.
+func (q *QueryBuilder) HasField(field Field) *QueryBuilder {
+	return q.Where(func(r *Record) bool {
+		return r.data[field] != ""
+	})
+}
+
+// FieldContains keeps records where field contains substr.
+func (q *QueryBuilder) FieldContains(field Field, substr string) *QueryBuilder {
+	return q.Where(func(r *Record) bool {
+		return strings.Contains(r.data[field], substr)
+	})
+}
+
+// FieldEquals keeps records where field equals value.
+func (q *QueryBuilder) FieldEquals(field Field, value string) *QueryBuilder {
+	return q.Where(func(r *Record) bool {
+		return r.data[field] == value
+	})
+}
+
+// Limit caps the number of returned records. n <= 0 means no limit.
+func (q *QueryBuilder) Limit(n int) *QueryBuilder {
+	q.limit = n
+	return q
+}
+
+// Execute applies all conditions and returns matched records in load order.
+func (q *QueryBuilder) Execute() []*Record {
+
+	q.h.mu.RLock()
+	defer q.h.mu.RUnlock()
+
+	results := make([]*Record, 0)
+	for _, record := range q.h.records {
+		if q.limit > 0 && len(results) >= q.limit {
+			break
+		}
+		if q.match(record) {
+			results = append(results, record)
+		}
+	}
+	return results
+}
+
+// match checks if a record satisfies all conditions.
+func (q *QueryBuilder) match(record *Record) bool {
+	for _, cond := range q.conditions {
+		if !cond(record) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package hgnc_go
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+// tableReader reads an HGNC file: leading comment lines, a header line, then rows.
+type tableReader struct {
+	rows         rowReader
+	headerMap    map[string]int // column name -> column index
+	releaseDate  time.Time      // found in comment lines, zero if none
+	commentBytes int64          // bytes consumed before the header line
+}
+
+// openTable reads everything up to and including the header line of r.
+func openTable(r io.Reader, delimiter rune) (*tableReader, error) {
+
+	br := bufio.NewReader(r)
+	t := &tableReader{}
+
+	// files saved on Windows may start with a UTF-8 BOM
+	if b, err := br.Peek(len(utf8BOM)); err == nil && string(b) == utf8BOM {
+		_, _ = br.Discard(len(utf8BOM))
+		t.commentBytes += int64(len(utf8BOM))
+	}
+
+	// skip leading comment lines ("# ...")
+	for {
+		if b, err := br.Peek(1); err != nil || b[0] != '#' {
+			break
+		}
+		comment, err := br.ReadString('\n')
+		t.commentBytes += int64(len(comment))
+		if releaseDate, ok := detectReleaseDate(comment); ok {
+			t.releaseDate = releaseDate
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	// read header line
+	t.rows = newRowReader(br, delimiter)
+	header, err := t.rows.read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("failed reading header line")
+		}
+		return nil, err
+	}
+	t.headerMap = make(map[string]int)
+	for i, field := range header {
+		f := strings.TrimSpace(field)
+		f = strings.Trim(f, "\"")
+		t.headerMap[f] = i
+	}
+
+	return t, nil
+}
+
+// offset returns bytes consumed so far.
+func (t *tableReader) offset() int64 {
+	return t.commentBytes + t.rows.offset()
+}
+
+// rowReader reads delimited rows of an HGNC file one by one.
+type rowReader interface {
+	read() ([]string, error) // returns io.EOF when there are no more rows
+	offset() int64           // bytes consumed so far
+}
+
+// newRowReader returns a rowReader for the delimiter.
+// TSV is split on tabs as is, other delimiters follow RFC 4180 quoting.
+func newRowReader(r io.Reader, delimiter rune) rowReader {
+	if delimiter == '\t' {
+		return &tsvRowReader{scanner: bufio.NewScanner(r)}
+	}
+	cr := csv.NewReader(r)
+	cr.Comma = delimiter
+	cr.FieldsPerRecord = -1 // rows may be shorter than header
+	cr.LazyQuotes = true
+	cr.ReuseRecord = true
+	return &csvRowReader{reader: cr}
+}
+
+// tsvRowReader splits lines on tabs.
+type tsvRowReader struct {
+	scanner *bufio.Scanner
+	n       int64
+}
+
+func (t *tsvRowReader) read() ([]string, error) {
+	if !t.scanner.Scan() {
+		if err := t.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	t.n += int64(len(t.scanner.Bytes())) + 1
+	line := strings.TrimSuffix(t.scanner.Text(), "\r") // \r\n line endings
+	return strings.Split(line, "\t"), nil
+}
+
+func (t *tsvRowReader) offset() int64 {
+	return t.n
+}
+
+// csvRowReader reads rows with encoding/csv.
+type csvRowReader struct {
+	reader *csv.Reader
+}
+
+func (c *csvRowReader) read() ([]string, error) {
+	return c.reader.Read()
+}
+
+func (c *csvRowReader) offset() int64 {
+	return c.reader.InputOffset()
+}
@@ -0,0 +1,97 @@
+package hgnc_go_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+)
+
+func TestLoadFromReaderLineEndings(t *testing.T) {
+
+	const (
+		bom  = "\ufeff"
+		head = "hgnc_id\tsymbol\tname\n"
+		tp53 = "HGNC:11998\tTP53\ttumor protein p53\n"
+	)
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"plain", head + tp53},
+		{"BOM", bom + head + tp53},
+		{"CRLF", strings.ReplaceAll(head+tp53, "\n", "\r\n")},
+		{"BOM and CRLF", bom + strings.ReplaceAll(head+tp53, "\n", "\r\n")},
+		{"BOM and comment lines", bom + "# HGNC complete set\r\n" + head + tp53},
+		{"no final newline", head + strings.TrimSuffix(tp53, "\n")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hgnc, err := h.LoadFromReader(strings.NewReader(tt.data), h.WithLogger(testLogger))
+			if err != nil {
+				t.Fatal(err)
+			}
+			records := hgnc.Fetch("HGNC:11998", h.FIELD_HGNC_ID)
+			if len(records) != 1 {
+				t.Fatalf("Fetch(HGNC:11998) returned %d records, want 1", len(records))
+			}
+			if got := records[0].Name(); got != "tumor protein p53" {
+				t.Errorf("name = %q, want %q", got, "tumor protein p53")
+			}
+			if got := len(hgnc.Fetch("TP53", h.FIELD_SYMBOL)); got != 1 {
+				t.Errorf("Fetch(TP53) returned %d records, want 1", got)
+			}
+		})
+	}
+}
+
+func TestLoadFromCSV(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		data     string
+		opts     []h.LoadOption
+		wantName string
+		wantPrev []string
+	}{
+		{"plain", "hgnc_id,symbol,name,prev_symbol\nHGNC:1100,BRCA1,BRCA1 DNA repair associated,BRCC1X\n",
+			nil, "BRCA1 DNA repair associated", []string{"BRCC1X"}},
+		{"quoted values", "\"hgnc_id\",\"symbol\",\"name\",\"prev_symbol\"\n\"HGNC:1100\",\"BRCA1\",\"BRCA1, DNA \"\"repair\"\" associated\",\"BRCC1X|RNF53\"\n",
+			nil, `BRCA1, DNA "repair" associated`, []string{"BRCC1X", "RNF53"}},
+		{"CRLF", "hgnc_id,symbol,name,prev_symbol\r\nHGNC:1100,BRCA1,BRCA1 DNA repair associated,\r\n",
+			nil, "BRCA1 DNA repair associated", nil},
+		{"delimiter option", "hgnc_id;symbol;name;prev_symbol\nHGNC:1100;BRCA1;BRCA1 DNA repair associated;BRCC1X\n",
+			[]h.LoadOption{h.WithDelimiter(';')}, "BRCA1 DNA repair associated", []string{"BRCC1X"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "hgnc.csv")
+			if err := os.WriteFile(path, []byte(tt.data), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			hgnc, err := h.LoadFromCSV(path, false, append(tt.opts, h.WithLogger(testLogger))...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			records := hgnc.Fetch("BRCA1", h.FIELD_SYMBOL)
+			if len(records) != 1 {
+				t.Fatalf("Fetch(BRCA1) returned %d records, want 1", len(records))
+			}
+			if got := records[0].Name(); got != tt.wantName {
+				t.Errorf("name = %q, want %q", got, tt.wantName)
+			}
+			if got := records[0].GetPrevSymbols(); !slices.Equal(got, tt.wantPrev) {
+				t.Errorf("prev symbols = %q, want %q", got, tt.wantPrev)
+			}
+			for _, prev := range tt.wantPrev {
+				if got := symbols(hgnc.Fetch(prev, h.FIELD_SYMBOL)); !slices.Equal(got, []string{"BRCA1"}) {
+					t.Errorf("Fetch(%q) = %q, want BRCA1", prev, got)
+				}
+			}
+		})
+	}
+}
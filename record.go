@@ -1,8 +1,12 @@
 package hgnc_go
 
 import (
+	"bufio"
 	"encoding/json"
+	"fmt"
 	"io"
+	"sort"
+	"strings"
 )
 
 // Record represents a single row of data from the HGNC data file.
@@ -10,6 +14,16 @@ type Record struct {
 	data map[Field]string
 }
 
+// NewRecord creates a Record from a map of field to value.
+// The map is copied, values are trimmed like those loaded from the TSV file.
+func NewRecord(data map[Field]string) *Record {
+	record := &Record{data: make(map[Field]string, len(data))}
+	for k, v := range data {
+		record.data[k] = strings.TrimSpace(v)
+	}
+	return record
+}
+
 // ToMap returns the internal map representation of the Record.
 func (r *Record) ToMap() map[Field]string {
 	copyMap := make(map[Field]string, len(r.data))
@@ -43,11 +57,85 @@ func (r *Record) Dumps() (string, error) {
 	return string(jsonBytes), nil
 }
 
+// String returns a one-line summary of the Record,
+// e.g. "HGNC:1100 BRCA1 (protein-coding gene, 17q21.31)".
+func (r *Record) String() string {
+	var details []string
+	for _, v := range []string{r.data[FIELD_LOCUS_GROUP], r.data[FIELD_LOCATION]} {
+		if v != "" {
+			details = append(details, v)
+		}
+	}
+	s := strings.TrimSpace(r.data[FIELD_HGNC_ID] + " " + r.data[FIELD_SYMBOL])
+	if len(details) > 0 {
+		s += " (" + strings.Join(details, ", ") + ")"
+	}
+	return s
+}
+
+// PrettyPrint writes the Record to w in a human-readable form: one non-empty field
+// per line with aligned values, fields holding several values are marked [multi-value].
+func (r *Record) PrettyPrint(w io.Writer) error {
+
+	fields := make([]Field, 0, len(r.data))
+	width := 0
+	for field, value := range r.data {
+		if value == "" {
+			continue
+		}
+		fields = append(fields, field)
+		width = max(width, len(field))
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i] < fields[j]
+	})
+
+	bw := bufio.NewWriter(w)
+	for _, field := range fields {
+		value := r.data[field]
+		line := fmt.Sprintf("%-*s  %s", width, field, value)
+		if IsMultiValueField(field) && len(SplitMultiValue(value)) > 1 {
+			line += "  [multi-value]"
+		}
+		bw.WriteString(line + "\n")
+	}
+	return bw.Flush()
+}
+
 // Get returns the value of the given field in the Record.
+// A nil Record has all fields empty.
 func (r *Record) Get(field Field) string {
+	if r == nil {
+		return ""
+	}
 	return r.data[field]
 }
 
+// GetAliasSymbols returns alias symbols of the Record, split on "|".
+func (r *Record) GetAliasSymbols() []string {
+	return SplitMultiValue(r.data[FIELD_ALIAS_SYMBOL])
+}
+
+// GetPrevSymbols returns previous symbols of the Record, split on "|".
+func (r *Record) GetPrevSymbols() []string {
+	return SplitMultiValue(r.data[FIELD_PREV_SYMBOL])
+}
+
+// HasManeSelect checks if the Record has a MANE Select transcript.
+func (r *Record) HasManeSelect() bool {
+	return r.data[FIELD_MANE_SELECT] != ""
+}
+
+// Validate checks format constraints of the Record, the same as ValidateRecords
+// except duplicate HGNC IDs, and returns a description of each issue found.
+func (r *Record) Validate() []string {
+	issues := make([]string, 0)
+	for _, issue := range recordIssues(r) {
+		issues = append(issues, issue.issue)
+	}
+	return issues
+}
+
 // -------------------------------------------------
 // Accessors for each field in the Record struct:
 
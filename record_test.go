@@ -0,0 +1,118 @@
+package hgnc_go_test
+
+import (
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestRecordString(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		record *h.Record
+		want   string
+	}{
+		{"TP53", testutil.FixtureTP53(), "HGNC:11998 TP53 (protein-coding gene, 17p13.1)"},
+		{"MIR21", testutil.FixtureMIR21(), "HGNC:31586 MIR21 (non-coding RNA, 17q23.1)"},
+		{"no location", h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("A1BG").WithLocusGroup(h.LocusGroupProteinCoding).Build(),
+			"HGNC:1 A1BG (protein-coding gene)"},
+		{"symbol only", h.NewRecordBuilder().WithSymbol("A1BG").Build(), "A1BG"},
+		{"empty", h.NewRecordBuilder().Build(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.record.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrettyPrint(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		record *h.Record
+		want   string
+	}{
+		{
+			"aligned and sorted",
+			h.NewRecordBuilder().WithHgncID("HGNC:11998").WithSymbol("TP53").WithAliasSymbols("p53", "LFS1").Build(),
+			"alias_symbol  p53|LFS1  [multi-value]\n" +
+				"hgnc_id       HGNC:11998\n" +
+				"symbol        TP53\n",
+		},
+		{
+			"single value of a multi-value field",
+			h.NewRecordBuilder().WithSymbol("MIR21").WithAliasSymbols("hsa-mir-21").Build(),
+			"alias_symbol  hsa-mir-21\n" +
+				"symbol        MIR21\n",
+		},
+		{"empty", h.NewRecordBuilder().Build(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf strings.Builder
+			if err := tt.record.PrettyPrint(&buf); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("PrettyPrint() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	// every non-empty field of a fixture is printed once
+	var buf strings.Builder
+	if err := testutil.FixtureTP53().PrettyPrint(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if want := len(testutil.FixtureTP53().ToMap()); len(lines) != want {
+		t.Errorf("PrettyPrint of TP53 wrote %d lines, want %d", len(lines), want)
+	}
+}
+
+func TestRecordValidate(t *testing.T) {
+
+	valid := func() *h.RecordBuilder {
+		return h.NewRecordBuilder().WithHgncID("HGNC:11998").WithSymbol("TP53").WithStatus(h.StatusApproved)
+	}
+
+	tests := []struct {
+		name   string
+		record *h.Record
+		want   []string // substrings of the issues, in order
+	}{
+		{"fixture", testutil.FixtureTP53(), nil},
+		{"minimal", valid().Build(), nil},
+		{"withdrawn", valid().WithStatus(h.StatusWithdrawn).Build(), nil},
+		{"missing hgnc id", valid().WithHgncID("").Build(), []string{"invalid HGNC ID"}},
+		{"malformed hgnc id", valid().WithHgncID("11998").Build(), []string{"invalid HGNC ID"}},
+		{"malformed ensembl id", valid().WithEnsemblGeneID("ENSG0001").Build(), []string{"invalid Ensembl gene ID"}},
+		{"versioned ensembl id", valid().WithEnsemblGeneID("ENSG00000141510.17").Build(), []string{"invalid Ensembl gene ID"}},
+		{"malformed entrez id", valid().WithEntrezID("71a57").Build(), []string{"invalid Entrez ID"}},
+		{"unknown status", valid().WithStatus("Pending").Build(), []string{"unknown status"}},
+		{"several issues", valid().WithHgncID("x").WithEntrezID("-").WithStatus("").Build(),
+			[]string{"invalid HGNC ID", "invalid Entrez ID", "unknown status"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := tt.record.Validate()
+			if issues == nil {
+				t.Fatal("Validate returned nil")
+			}
+			if len(issues) != len(tt.want) {
+				t.Fatalf("Validate() = %q, want %d issues", issues, len(tt.want))
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(issues[i], want) {
+					t.Errorf("issue %d = %q, want it to contain %q", i, issues[i], want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package hgnc_go
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRESTBaseURL is the base URL of the public HGNC REST API.
+const DefaultRESTBaseURL = "https://rest.genenames.org"
+
+// ErrNotFound is returned when no record matches a gene identifier.
+var ErrNotFound = errors.New("gene not found")
+
+// RESTClient queries the HGNC REST API (https://www.genenames.org/help/rest/)
+// and returns the same *Record type as the local database.
+type RESTClient struct {
+	baseURL    string
+	httpClient *http.Client
+	timeout    *time.Duration // set by WithTimeout, applied to a copy of httpClient
+
+	// rate limit: at most one request per minInterval
+	minInterval time.Duration
+	mu          sync.Mutex
+	nextAllowed time.Time
+}
+
+// ClientOption configures a RESTClient.
+type ClientOption func(*RESTClient)
+
+// WithHTTPClient sets the underlying http.Client, nil means the default client.
+// The client is never modified, see WithTimeout.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *RESTClient) {
+		c.httpClient = client
+	}
+}
+
+// WithTimeout sets the timeout of each request, 0 means no timeout. It applies to a copy of
+// the http.Client (see WithHTTPClient), whatever the order of options.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *RESTClient) {
+		c.timeout = &timeout
+	}
+}
+
+// WithRateLimit limits requests to rps per second. HGNC asks clients to
+// stay below 10 requests per second, which is the default.
+// rps <= 0 disables rate limiting.
+func WithRateLimit(rps float64) ClientOption {
+	return func(c *RESTClient) {
+		if rps <= 0 {
+			c.minInterval = 0
+			return
+		}
+		c.minInterval = time.Duration(float64(time.Second) / rps)
+	}
+}
+
+// NewRESTClient creates a client of the HGNC REST API.
+// An empty baseURL means DefaultRESTBaseURL.
+func NewRESTClient(baseURL string, opts ...ClientOption) *RESTClient {
+
+	if baseURL == "" {
+		baseURL = DefaultRESTBaseURL
+	}
+
+	c := &RESTClient{
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		httpClient:  newDefaultHTTPClient(),
+		minInterval: 100 * time.Millisecond,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = newDefaultHTTPClient()
+	}
+	if c.timeout != nil {
+		// never modify a shared client, e.g. http.DefaultClient
+		cp := *c.httpClient
+		cp.Timeout = *c.timeout
+		c.httpClient = &cp
+	}
+	return c
+}
+
+// newDefaultHTTPClient creates the http.Client used unless WithHTTPClient is given.
+func newDefaultHTTPClient() *http.Client {
+	return &http.Client{Timeout: 30 * time.Second}
+}
+
+// FetchBySymbol fetches the record of an approved symbol.
+func (c *RESTClient) FetchBySymbol(symbol string) (*Record, error) {
+	return c.fetchOne("symbol", symbol)
+}
+
+// FetchByHgncID fetches the record of an HGNC ID, e.g. "HGNC:1100".
+func (c *RESTClient) FetchByHgncID(hgncID string) (*Record, error) {
+	return c.fetchOne("hgnc_id", hgncID)
+}
+
+// FetchByEntrezID fetches the record of an Entrez gene ID.
+func (c *RESTClient) FetchByEntrezID(entrezID string) (*Record, error) {
+	return c.fetchOne("entrez_id", entrezID)
+}
+
+// fetchOne fetches the first record where field equals value.
+// It returns ErrNotFound if there is none.
+func (c *RESTClient) fetchOne(field, value string) (*Record, error) {
+	records, err := c.fetch(field, value)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s %q: %w", field, value, ErrNotFound)
+	}
+	return records[0], nil
+}
+
+// fetch calls the /fetch/{field}/{value} endpoint.
+func (c *RESTClient) fetch(field, value string) ([]*Record, error) {
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, fmt.Errorf("%s: empty value", field)
+	}
+
+	c.wait()
+
+	endpoint := c.baseURL + "/fetch/" + field + "/" + url.PathEscape(value)
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("GET %s: %s: %s", endpoint, resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var payload restResponse
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("GET %s: decoding response: %w", endpoint, err)
+	}
+
+	records := make([]*Record, 0, len(payload.Response.Docs))
+	for _, doc := range payload.Response.Docs {
+		records = append(records, jsonDoc2Record(doc))
+	}
+	return records, nil
+}
+
+// wait blocks until the rate limit allows the next request.
+func (c *RESTClient) wait() {
+
+	if c.minInterval <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	now := time.Now()
+	slot := c.nextAllowed
+	if slot.Before(now) {
+		slot = now
+	}
+	c.nextAllowed = slot.Add(c.minInterval)
+	c.mu.Unlock()
+
+	time.Sleep(time.Until(slot))
+}
+
+// restResponse is the JSON envelope of the HGNC REST API (and of the JSON bulk download).
+type restResponse struct {
+	Response struct {
+		NumFound int                          `json:"numFound"`
+		Docs     []map[string]json.RawMessage `json:"docs"`
+	} `json:"response"`
+}
+
+// restFieldAliases maps JSON keys that differ from TSV headers to fields.
+var restFieldAliases = map[string]Field{
+	"gene_group":    FIELD_GENE_FAMILY,
+	"gene_group_id": FIELD_GENE_FAMILY_ID,
+}
+
+// jsonDoc2Record converts a JSON document of the HGNC REST API to a Record.
+// Arrays are joined with "|" so values are the same as in the TSV file.
+func jsonDoc2Record(doc map[string]json.RawMessage) *Record {
+
+	record := new(Record)
+	record.data = make(map[Field]string)
+
+	for key, raw := range doc {
+		if strings.HasPrefix(key, "_") {
+			// internal keys of the search engine, e.g. "_version_"
+			continue
+		}
+		field := Field(key)
+		if alias, ok := restFieldAliases[key]; ok {
+			field = alias
+		}
+		record.data[field] = jsonValue2String(raw)
+	}
+
+	// the same set of fields as a record loaded from TSV
+	for field := range fieldDesc {
+		if _, ok := record.data[field]; !ok {
+			record.data[field] = ""
+		}
+	}
+
+	return record
+}
+
+// jsonValue2String converts a JSON string, number or array of them to a field value.
+func jsonValue2String(raw json.RawMessage) string {
+
+	var values []json.RawMessage
+	if err := json.Unmarshal(raw, &values); err == nil {
+		parts := make([]string, 0, len(values))
+		for _, v := range values {
+			if s := jsonScalar2String(v); s != "" {
+				parts = append(parts, s)
+			}
+		}
+		return strings.Join(parts, multiValueDelimiter)
+	}
+	return jsonScalar2String(raw)
+}
+
+// jsonScalar2String converts a JSON string or number to a trimmed string.
+func jsonScalar2String(raw json.RawMessage) string {
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return strings.TrimSpace(s)
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		if i, err := strconv.ParseInt(n.String(), 10, 64); err == nil {
+			return strconv.FormatInt(i, 10)
+		}
+		return n.String()
+	}
+	return ""
+}
+
+// MarshalHGNCJSON encodes the Record as a JSON document of the HGNC REST API:
+// multi-value fields are arrays, keys follow the REST names (e.g. "gene_group"),
+// and empty fields are omitted. See UnmarshalHGNCJSON for the reverse.
+func (r *Record) MarshalHGNCJSON() ([]byte, error) {
+
+	restKeys := make(map[Field]string, len(restFieldAliases))
+	for key, field := range restFieldAliases {
+		restKeys[field] = key
+	}
+
+	doc := make(map[string]interface{}, len(r.data))
+	for field, value := range r.data {
+		if value == "" {
+			continue
+		}
+		key := string(field)
+		if restKey, ok := restKeys[field]; ok {
+			key = restKey
+		}
+		if multiValueFields[field] {
+			doc[key] = SplitMultiValue(value)
+		} else {
+			doc[key] = value
+		}
+	}
+	return json.Marshal(doc)
+}
+
+// UnmarshalHGNCJSON parses a JSON document of the HGNC REST API (one element of
+// response.docs) into a Record, the same way as LoadJSON.
+func UnmarshalHGNCJSON(data []byte) (*Record, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed decoding HGNC JSON: %w", err)
+	}
+	return jsonDoc2Record(doc), nil
+}
@@ -0,0 +1,116 @@
+package hgnc_go_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestMarshalHGNCJSON(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		record *h.Record
+		want   map[string]interface{}
+	}{
+		{
+			"rest keys and arrays",
+			h.NewRecordBuilder().WithHgncID("HGNC:1100").WithSymbol("BRCA1").WithAliasSymbols("RNF53", "BRCC1").
+				With(h.FIELD_GENE_FAMILY, "Ring finger proteins|BRCA1 A complex").With(h.FIELD_GENE_FAMILY_ID, "58|1328").
+				WithEntrezID("672").Build(),
+			map[string]interface{}{
+				"hgnc_id":       "HGNC:1100",
+				"symbol":        "BRCA1",
+				"alias_symbol":  []interface{}{"RNF53", "BRCC1"},
+				"gene_group":    []interface{}{"Ring finger proteins", "BRCA1 A complex"},
+				"gene_group_id": []interface{}{"58", "1328"},
+				"entrez_id":     "672",
+			},
+		},
+		{
+			"single value of a multi-value field",
+			h.NewRecordBuilder().WithSymbol("MIR21").WithAliasSymbols("hsa-mir-21").Build(),
+			map[string]interface{}{"symbol": "MIR21", "alias_symbol": []interface{}{"hsa-mir-21"}},
+		},
+		{"empty fields are omitted", h.NewRecordBuilder().Build(), map[string]interface{}{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.record.MarshalHGNCJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got map[string]interface{}
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("MarshalHGNCJSON() is not a JSON object: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MarshalHGNCJSON() = %s, want %v", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalHGNCJSON(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		data    string
+		want    map[h.Field]string
+		wantErr bool
+	}{
+		{
+			"rest document",
+			`{"hgnc_id": "HGNC:1100", "symbol": " BRCA1 ", "entrez_id": 672, "alias_symbol": ["RNF53", "", "BRCC1"],
+			 "gene_group": ["Ring finger proteins"], "gene_group_id": [58], "_version_": 1}`,
+			map[h.Field]string{
+				h.FIELD_HGNC_ID:        "HGNC:1100",
+				h.FIELD_SYMBOL:         "BRCA1",
+				h.FIELD_ENTREZ_ID:      "672",
+				h.FIELD_ALIAS_SYMBOL:   "RNF53|BRCC1",
+				h.FIELD_GENE_FAMILY:    "Ring finger proteins",
+				h.FIELD_GENE_FAMILY_ID: "58",
+				h.FIELD_NAME:           "",
+			},
+			false,
+		},
+		{"empty document", `{}`, map[h.Field]string{h.FIELD_SYMBOL: ""}, false},
+		{"invalid json", `{"symbol": `, nil, true},
+		{"not an object", `["BRCA1"]`, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := h.UnmarshalHGNCJSON([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalHGNCJSON() error = %v, want error: %v", err, tt.wantErr)
+			}
+			for field, want := range tt.want {
+				if got := record.Get(field); got != want {
+					t.Errorf("%s = %q, want %q", field, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestHGNCJSONRoundTrip(t *testing.T) {
+
+	for _, record := range []*h.Record{testutil.FixtureTP53(), testutil.FixtureBRCA1(), testutil.FixtureMIR21()} {
+		t.Run(record.Symbol(), func(t *testing.T) {
+			data, err := record.MarshalHGNCJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded, err := h.UnmarshalHGNCJSON(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if changes := record.CompareFields(decoded); len(changes) > 0 {
+				t.Errorf("fields changed by a round trip: %+v", changes)
+			}
+		})
+	}
+}
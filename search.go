@@ -1,5 +1,14 @@
 package hgnc_go
 
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
 // Fetch retrieves records from HGNC based on the given value and query field.
 // (similar to grep command in Unix)
 func (h *HGNC) Fetch(value string, query Field) []*Record {
@@ -8,6 +17,25 @@ func (h *HGNC) Fetch(value string, query Field) []*Record {
 		panic("HGNC is nil")
 	}
 
+	h.ensureLazyIndex(query)
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	start := time.Now()
+	if logger := h.log(); logger.Enabled(context.Background(), slog.LevelDebug) {
+		logger.Debug("hgnc: fetch", "value", value, "query", query.String(), "cached", h.caches[query] != nil)
+	}
+	results := h.fetch(value, query)
+	if h.metrics != nil {
+		// a cache hit is a value found in the cache, not only a query on a cached field
+		h.metrics.observeQuery(query, h.caches[query] != nil && len(results) > 0, start)
+	}
+	return results
+}
+
+// fetch is the lock-free implementation of Fetch.
+func (h *HGNC) fetch(value string, query Field) []*Record {
+
 	if value == "" {
 		return make([]*Record, 0)
 	}
@@ -16,10 +44,10 @@ func (h *HGNC) Fetch(value string, query Field) []*Record {
 		value = h.normalizeSymbol(value)
 	}
 
-	if _, ok1 := h.caches[query]; ok1 {
+	if cache := h.caches[query]; cache != nil {
 		// cached
 		// h.caches[query][value] is a slice of indexes of h.records, type: []int
-		if indexes, ok2 := h.caches[query][value]; ok2 {
+		if indexes, ok := cache[value]; ok {
 			results := make([]*Record, 0, len(indexes))
 			for _, index := range indexes {
 				results = append(results, h.records[index])
@@ -30,7 +58,7 @@ func (h *HGNC) Fetch(value string, query Field) []*Record {
 	} else {
 		var results []*Record
 		for _, record := range h.records {
-			if record.data[query] == value {
+			if matchValue(record, query, value) {
 				results = append(results, record)
 			}
 		}
@@ -38,6 +66,48 @@ func (h *HGNC) Fetch(value string, query Field) []*Record {
 	}
 }
 
+// FetchCI (case-insensitive) is like Fetch, but compares values ignoring case, e.g. "brca1" finds BRCA1.
+// Indexed fields use a separate upper-cased cache, built on first use unless loaded
+// with WithCaseInsensitiveIndex, other fields are scanned with strings.EqualFold.
+func (h *HGNC) FetchCI(value string, query Field) []*Record {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.ensureCIIndex(query)
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return make([]*Record, 0)
+	}
+
+	if query == FIELD_SYMBOL {
+		// aliases and previous symbols with exact case
+		value = h.normalizeSymbol(value)
+	}
+
+	results := make([]*Record, 0)
+	if cache, ok := h.ciCaches[query]; ok {
+		for _, index := range cache[strings.ToUpper(value)] {
+			results = append(results, h.records[index])
+		}
+		return results
+	}
+	for _, record := range h.records {
+		for _, key := range cacheKeys(query, record.data[query]) {
+			if strings.EqualFold(key, value) {
+				results = append(results, record)
+				break
+			}
+		}
+	}
+	return results
+}
+
 // Lookup retrieves values of target field for records in HGNC based on the given value and query field.
 // (similar to grep + cut command in Unix)
 func (h *HGNC) Lookup(value string, query, target Field) []string {
@@ -46,6 +116,25 @@ func (h *HGNC) Lookup(value string, query, target Field) []string {
 		panic("HGNC is nil")
 	}
 
+	h.ensureLazyIndex(query)
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	start := time.Now()
+	if logger := h.log(); logger.Enabled(context.Background(), slog.LevelDebug) {
+		logger.Debug("hgnc: lookup", "value", value, "query", query.String(), "cached", h.caches[query] != nil)
+	}
+	results := h.lookup(value, query, target)
+	if h.metrics != nil {
+		// a cache hit is a value found in the cache, not only a query on a cached field
+		h.metrics.observeQuery(query, h.caches[query] != nil && len(results) > 0, start)
+	}
+	return results
+}
+
+// lookup is the lock-free implementation of Lookup.
+func (h *HGNC) lookup(value string, query, target Field) []string {
+
 	if value == "" {
 		return make([]string, 0)
 	}
@@ -54,12 +143,12 @@ func (h *HGNC) Lookup(value string, query, target Field) []string {
 		value = h.normalizeSymbol(value)
 	}
 
-	if _, ok1 := h.caches[query]; ok1 {
+	if cache := h.caches[query]; cache != nil {
 		// cached
 		// hgnc.caches -> map[Field]Cache
 		// hgnc.caches[field] -> cache -> map[string][]int
 		// hgnc.caches[field][value] -> []int
-		if indexes, ok2 := h.caches[query][value]; ok2 {
+		if indexes, ok := cache[value]; ok {
 			results := make([]string, 0, len(indexes))
 			for _, index := range indexes {
 				results = append(results, h.records[index].data[target])
@@ -71,10 +160,286 @@ func (h *HGNC) Lookup(value string, query, target Field) []string {
 		// no cache
 		var results []string
 		for _, record := range h.records {
-			if record.data[query] == value {
+			if matchValue(record, query, value) {
 				results = append(results, record.data[target])
 			}
 		}
 		return results
 	}
 }
+
+// Filter retrieves records from HGNC for which predicate returns true.
+// Records are returned in the order they were loaded.
+func (h *HGNC) Filter(predicate func(*Record) bool) []*Record {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.filter(predicate)
+}
+
+// filter is the lock-free implementation of Filter.
+func (h *HGNC) filter(predicate func(*Record) bool) []*Record {
+
+	results := make([]*Record, 0)
+	for _, record := range h.records {
+		if predicate(record) {
+			results = append(results, record)
+		}
+	}
+	return results
+}
+
+// ParallelFilter is the concurrent version of Filter. Records are split into
+// `workers` chunks which are filtered in separate goroutines, and the results
+// are merged in load order, so the output is identical to Filter.
+func (h *HGNC) ParallelFilter(predicate func(*Record) bool, workers int) []*Record {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(h.records) {
+		workers = len(h.records)
+	}
+	if workers <= 1 {
+		return h.filter(predicate)
+	}
+
+	// each worker writes to its own slot, no locking needed
+	chunkSize := (len(h.records) + workers - 1) / workers
+	chunkResults := make([][]*Record, workers)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start := w * chunkSize
+		end := min(start+chunkSize, len(h.records))
+		if start >= end {
+			continue
+		}
+		wg.Add(1)
+		go func(w int, chunk []*Record) {
+			defer wg.Done()
+			var matched []*Record
+			for _, record := range chunk {
+				if predicate(record) {
+					matched = append(matched, record)
+				}
+			}
+			chunkResults[w] = matched
+		}(w, h.records[start:end])
+	}
+	wg.Wait()
+
+	// merge
+	total := 0
+	for _, matched := range chunkResults {
+		total += len(matched)
+	}
+	results := make([]*Record, 0, total)
+	for _, matched := range chunkResults {
+		results = append(results, matched...)
+	}
+	return results
+}
+
+// LookupMultiple is like Lookup, but retrieves values of several target fields at once.
+// The query value is resolved only once, and the result maps each target field to
+// its values for the matched records (in the same order for every target).
+func (h *HGNC) LookupMultiple(value string, query Field, targets []Field) map[Field][]string {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	results := make(map[Field][]string, len(targets))
+	for _, target := range targets {
+		results[target] = make([]string, 0)
+	}
+
+	h.ensureLazyIndex(query)
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, record := range h.fetch(value, query) {
+		for _, target := range targets {
+			results[target] = append(results[target], record.data[target])
+		}
+	}
+	return results
+}
+
+// MultiQuery fetches records matching value in any of the default indexed fields (symbol, hgnc id,
+// entrez id, ...), querying the fields concurrently. Use it when the id system of value is unknown,
+// it is slower but more thorough than classifying value. Records are deduplicated by HGNC ID and
+// returned in the order of indexed fields, then load order.
+func (h *HGNC) MultiQuery(value string) []*Record {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return make([]*Record, 0)
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	// each field writes to its own slot, no locking needed
+	found := make([][]*Record, len(indexedFields))
+	var wg sync.WaitGroup
+	for i, field := range indexedFields {
+		wg.Add(1)
+		go func(i int, field Field) {
+			defer wg.Done()
+			found[i] = h.fetch(value, field)
+		}(i, field)
+	}
+	wg.Wait()
+
+	results := make([]*Record, 0)
+	seen := make(map[string]struct{})
+	seenRecords := make(map[*Record]struct{}) // records without HGNC ID
+	for _, records := range found {
+		for _, record := range records {
+			if hgncID := record.data[FIELD_HGNC_ID]; hgncID != "" {
+				if _, ok := seen[hgncID]; ok {
+					continue
+				}
+				seen[hgncID] = struct{}{}
+			} else {
+				if _, ok := seenRecords[record]; ok {
+					continue
+				}
+				seenRecords[record] = struct{}{}
+			}
+			results = append(results, record)
+		}
+	}
+	return results
+}
+
+// BatchFetch fetches records for many values of the same query field concurrently,
+// using one goroutine per CPU. The result maps each value to its records
+// (empty if nothing matched).
+func (h *HGNC) BatchFetch(values []string, query Field) map[string][]*Record {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.ensureLazyIndex(query)
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	found := make([][]*Record, len(values))
+	workers := min(runtime.NumCPU(), len(values))
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			// worker w handles values w, w+workers, w+2*workers, ...
+			for i := w; i < len(values); i += workers {
+				found[i] = h.fetch(values[i], query)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	results := make(map[string][]*Record, len(values))
+	for i, value := range values {
+		results[value] = found[i]
+	}
+	return results
+}
+
+// BatchFetchRateLimited is like BatchFetch, but fetches values one by one in the calling
+// goroutine, at most rps per second, so it doesn't compete for CPU with other workloads.
+// The lock is taken per value, writers are not blocked for the whole batch.
+// rps <= 0 disables rate limiting, rps above 1e9 is limited to 1e9.
+func (h *HGNC) BatchFetchRateLimited(values []string, query Field, rps int) map[string][]*Record {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	var tick <-chan time.Time
+	if rps > 0 {
+		// at least 1ns, the finest ticker interval, for rps above 1e9
+		ticker := time.NewTicker(max(time.Second/time.Duration(rps), time.Nanosecond))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	results := make(map[string][]*Record, len(values))
+	for i, value := range values {
+		if tick != nil && i > 0 {
+			<-tick
+		}
+		results[value] = h.Fetch(value, query)
+	}
+	return results
+}
+
+// SearchByName retrieves records whose name contains substring, e.g. "kinase".
+// FIELD_NAME is not indexed, so all records are scanned. An empty substring matches nothing.
+func (h *HGNC) SearchByName(substring string, caseSensitive bool) []*Record {
+
+	contains := substringMatcher(substring, caseSensitive)
+	if contains == nil {
+		return make([]*Record, 0)
+	}
+	return h.Filter(func(r *Record) bool {
+		return contains(r.data[FIELD_NAME])
+	})
+}
+
+// SearchByAliasName is like SearchByName, but matches each alias name (FIELD_ALIAS_NAME, split on "|")
+// separately, so substring never spans two alias names.
+func (h *HGNC) SearchByAliasName(substring string, caseSensitive bool) []*Record {
+
+	contains := substringMatcher(substring, caseSensitive)
+	if contains == nil {
+		return make([]*Record, 0)
+	}
+	return h.Filter(func(r *Record) bool {
+		for _, aliasName := range SplitMultiValue(r.data[FIELD_ALIAS_NAME]) {
+			if contains(aliasName) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// substringMatcher returns a function checking if a string contains substring,
+// nil if substring is empty.
+func substringMatcher(substring string, caseSensitive bool) func(string) bool {
+	if substring == "" {
+		return nil
+	}
+	if caseSensitive {
+		return func(s string) bool {
+			return strings.Contains(s, substring)
+		}
+	}
+	substring = strings.ToLower(substring)
+	return func(s string) bool {
+		return strings.Contains(strings.ToLower(s), substring)
+	}
+}
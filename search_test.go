@@ -0,0 +1,270 @@
+package hgnc_go_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+// symbols returns the symbols of records, in order.
+func symbols(records []*h.Record) []string {
+	result := make([]string, len(records))
+	for i, record := range records {
+		result[i] = record.Get(h.FIELD_SYMBOL)
+	}
+	return result
+}
+
+func TestParallelFilter(t *testing.T) {
+
+	hgnc := testutil.NewSyntheticHGNC(1000)
+	coding := func(r *h.Record) bool { return r.LocusGroupEnum() == h.LocusGroupProteinCoding }
+	want := symbols(hgnc.Filter(coding))
+	if len(want) != 250 {
+		t.Fatalf("Filter returned %d records, want 250", len(want))
+	}
+
+	tests := []struct {
+		name      string
+		predicate func(*h.Record) bool
+		workers   int
+		want      []string
+	}{
+		{"one worker", coding, 1, want},
+		{"several workers", coding, 7, want},
+		{"more workers than records", coding, 5000, want},
+		{"zero workers", coding, 0, want},
+		{"negative workers", coding, -3, want},
+		{"no match", func(*h.Record) bool { return false }, 4, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hgnc.ParallelFilter(tt.predicate, tt.workers)
+			if got == nil {
+				t.Fatal("ParallelFilter returned nil")
+			}
+			if !slices.Equal(symbols(got), tt.want) {
+				t.Errorf("ParallelFilter(workers=%d) returned %d records, not those of Filter in load order", tt.workers, len(got))
+			}
+		})
+	}
+}
+
+func TestLookupMultiple(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1())
+	targets := []h.Field{h.FIELD_ENTREZ_ID, h.FIELD_ENSEMBL_GENE_ID}
+
+	tests := []struct {
+		name  string
+		value string
+		query h.Field
+		want  map[h.Field][]string
+	}{
+		{"symbol", "TP53", h.FIELD_SYMBOL, map[h.Field][]string{
+			h.FIELD_ENTREZ_ID:       {"7157"},
+			h.FIELD_ENSEMBL_GENE_ID: {"ENSG00000141510"},
+		}},
+		{"alias", "p53", h.FIELD_SYMBOL, map[h.Field][]string{
+			h.FIELD_ENTREZ_ID:       {"7157"},
+			h.FIELD_ENSEMBL_GENE_ID: {"ENSG00000141510"},
+		}},
+		{"entrez id", "672", h.FIELD_ENTREZ_ID, map[h.Field][]string{
+			h.FIELD_ENTREZ_ID:       {"672"},
+			h.FIELD_ENSEMBL_GENE_ID: {"ENSG00000012048"},
+		}},
+		{"not found", "NOPE", h.FIELD_SYMBOL, map[h.Field][]string{
+			h.FIELD_ENTREZ_ID:       {},
+			h.FIELD_ENSEMBL_GENE_ID: {},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hgnc.LookupMultiple(tt.value, tt.query, targets)
+			if len(got) != len(tt.want) {
+				t.Fatalf("LookupMultiple(%q) returned %d targets, want %d", tt.value, len(got), len(tt.want))
+			}
+			for target, want := range tt.want {
+				if got[target] == nil || !slices.Equal(got[target], want) {
+					t.Errorf("LookupMultiple(%q)[%s] = %q, want %q", tt.value, target, got[target], want)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchCI(t *testing.T) {
+
+	records := []*h.Record{testutil.FixtureTP53(), testutil.FixtureBRCA1()}
+	eager, err := h.LoadFromReader(strings.NewReader(header(h.AllFieldNames()...)), h.WithCaseInsensitiveIndex(), h.WithLogger(testLogger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, record := range records {
+		eager.AddRecord(record)
+	}
+	databases := map[string]*h.HGNC{
+		"lazy":  testutil.NewMockHGNC(records...),
+		"eager": eager,
+	}
+
+	tests := []struct {
+		value string
+		query h.Field
+		want  []string
+	}{
+		{"brca1", h.FIELD_SYMBOL, []string{"BRCA1"}},
+		{"Brca1", h.FIELD_SYMBOL, []string{"BRCA1"}},
+		{"p53", h.FIELD_SYMBOL, []string{"TP53"}},
+		{" tp53 ", h.FIELD_SYMBOL, []string{"TP53"}},
+		{"hgnc:1100", h.FIELD_HGNC_ID, []string{"BRCA1"}},
+		{"ensg00000141510", h.FIELD_ENSEMBL_GENE_ID, []string{"TP53"}},
+		{"ccds45605", h.FIELD_CCDS_ID, []string{"TP53"}},
+		{"TUMOR PROTEIN P53", h.FIELD_NAME, []string{"TP53"}},
+		{"tumor protein", h.FIELD_NAME, []string{}},
+		{"", h.FIELD_SYMBOL, []string{}},
+	}
+	for name, hgnc := range databases {
+		for _, tt := range tests {
+			t.Run(name+" "+tt.value, func(t *testing.T) {
+				got := hgnc.FetchCI(tt.value, tt.query)
+				if got == nil || !slices.Equal(symbols(got), tt.want) {
+					t.Errorf("FetchCI(%q, %s) = %q, want %q", tt.value, tt.query, symbols(got), tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestBatchFetchRateLimited(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1())
+	values := []string{"TP53", "p53", "BRCA1", "NOPE", "TP53"}
+	want := map[string][]string{"TP53": {"TP53"}, "p53": {"TP53"}, "BRCA1": {"BRCA1"}, "NOPE": {}}
+
+	tests := []struct {
+		name    string
+		rps     int
+		minTime time.Duration
+	}{
+		{"not limited", 0, 0},
+		{"negative rps", -1, 0},
+		{"limited", 100, 4 * 10 * time.Millisecond},
+		{"rps above 1e9", 2e9, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			got := hgnc.BatchFetchRateLimited(values, h.FIELD_SYMBOL, tt.rps)
+			if elapsed := time.Since(start); elapsed < tt.minTime {
+				t.Errorf("BatchFetchRateLimited(rps=%d) took %v, want at least %v", tt.rps, elapsed, tt.minTime)
+			}
+			if len(got) != len(want) {
+				t.Fatalf("BatchFetchRateLimited returned %d values, want %d", len(got), len(want))
+			}
+			for value, wantSymbols := range want {
+				if records, ok := got[value]; !ok || !slices.Equal(symbols(records), wantSymbols) {
+					t.Errorf("BatchFetchRateLimited()[%q] = %q, want %q", value, symbols(records), wantSymbols)
+				}
+			}
+		})
+	}
+}
+
+func TestMultiQuery(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1(), testutil.FixtureMIR21(),
+		h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("SHARED").WithAliasSymbols("P04637").Build(),
+		h.NewRecordBuilder().WithHgncID("HGNC:2").WithSymbol("SELF").WithAliasSymbols("HGNC:2").Build())
+
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{"TP53", []string{"TP53"}},
+		{"p53", []string{"TP53"}},
+		{"HGNC:1100", []string{"BRCA1"}},
+		{"406991", []string{"MIR21"}},
+		{"ENSG00000141510", []string{"TP53"}},
+		{"uc002ict.4", []string{"BRCA1"}},
+		{"NM_007294", []string{"BRCA1"}},
+		{"191170", []string{"TP53"}},
+		{" P38398 ", []string{"BRCA1"}},
+		// symbol matches come before uniprot matches
+		{"P04637", []string{"SHARED", "TP53"}},
+		// a record matching in several fields is returned once
+		{"HGNC:2", []string{"SELF"}},
+		{"NOPE", []string{}},
+		{"", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := hgnc.MultiQuery(tt.value)
+			if got == nil || !slices.Equal(symbols(got), tt.want) {
+				t.Errorf("MultiQuery(%q) = %q, want %q", tt.value, symbols(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchByName(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+
+	tests := []struct {
+		name          string
+		substring     string
+		caseSensitive bool
+		want          []string
+	}{
+		{"whole name", "tumor protein p53", false, []string{"TP53"}},
+		{"substring", "DNA repair", true, []string{"BRCA1"}},
+		{"case-insensitive", "microrna", false, []string{"MIR21"}},
+		{"case-sensitive mismatch", "microrna", true, []string{}},
+		{"several matches", "r", false, []string{"TP53", "BRCA1", "MIR21"}},
+		{"no match", "kinase", false, []string{}},
+		{"empty substring", "", false, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hgnc.SearchByName(tt.substring, tt.caseSensitive)
+			if got == nil || !slices.Equal(symbols(got), tt.want) {
+				t.Errorf("SearchByName(%q, %v) = %q, want %q", tt.substring, tt.caseSensitive, symbols(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchByAliasName(t *testing.T) {
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1(),
+		h.NewRecordBuilder().WithHgncID("HGNC:1").WithSymbol("TWONAMES").
+			With(h.FIELD_ALIAS_NAME, "first kinase|second protein").Build())
+
+	tests := []struct {
+		name          string
+		substring     string
+		caseSensitive bool
+		want          []string
+	}{
+		{"whole alias name", "Li-Fraumeni syndrome", true, []string{"TP53"}},
+		{"case-insensitive", "li-fraumeni", false, []string{"TP53"}},
+		{"case-sensitive mismatch", "li-fraumeni", true, []string{}},
+		{"second alias name", "second", false, []string{"TWONAMES"}},
+		{"spanning two alias names", "kinase|second", false, []string{}},
+		{"name is not an alias name", "tumor protein", false, []string{}},
+		{"empty substring", "", false, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hgnc.SearchByAliasName(tt.substring, tt.caseSensitive)
+			if got == nil || !slices.Equal(symbols(got), tt.want) {
+				t.Errorf("SearchByAliasName(%q, %v) = %q, want %q", tt.substring, tt.caseSensitive, symbols(got), tt.want)
+			}
+		})
+	}
+}
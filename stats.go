@@ -0,0 +1,136 @@
+package hgnc_go
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// Stats is an overview of an HGNC database.
+type Stats struct {
+	Records       int       `json:"records"`
+	Approved      int       `json:"approved"`
+	Withdrawn     int       `json:"withdrawn"`
+	IndexedFields []Field   `json:"indexed_fields"`
+	ReleaseDate   time.Time `json:"release_date"`
+	SourceURL     string    `json:"source_url"`
+}
+
+// Stats returns an overview of the database.
+func (h *HGNC) Stats() Stats {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	stats := Stats{
+		Records:       len(h.records),
+		IndexedFields: make([]Field, 0, len(h.caches)),
+		ReleaseDate:   h.releaseDate,
+		SourceURL:     h.sourceURL,
+	}
+	for _, record := range h.records {
+		switch record.StatusEnum() {
+		case StatusApproved:
+			stats.Approved++
+		case StatusWithdrawn:
+			stats.Withdrawn++
+		}
+	}
+	for field, cache := range h.caches {
+		if cache == nil {
+			continue
+		}
+		stats.IndexedFields = append(stats.IndexedFields, field)
+	}
+	sort.Slice(stats.IndexedFields, func(i, j int) bool {
+		return stats.IndexedFields[i] < stats.IndexedFields[j]
+	})
+
+	return stats
+}
+
+// RecordCount returns the number of records.
+func (h *HGNC) RecordCount() int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.records)
+}
+
+// FieldHistogram counts records by value of the field. Empty values are counted under "".
+func (h *HGNC) FieldHistogram(field Field) map[string]int {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	histogram := make(map[string]int)
+	for _, record := range h.records {
+		histogram[record.data[field]]++
+	}
+	return histogram
+}
+
+// String returns a one-line summary of the database, e.g.
+// "HGNC database: 45231 records (38141 approved, 7090 withdrawn), 7 indexed fields".
+func (h *HGNC) String() string {
+	stats := h.Stats()
+	return fmt.Sprintf(
+		"HGNC database: %d records (%d approved, %d withdrawn), %d indexed fields",
+		stats.Records, stats.Approved, stats.Withdrawn, len(stats.IndexedFields),
+	)
+}
+
+// Summary writes a multi-line report of the database to w,
+// including record counts by locus group and the indexed fields.
+func (h *HGNC) Summary(w io.Writer) error {
+
+	stats := h.Stats()
+	locusGroups := h.FieldHistogram(FIELD_LOCUS_GROUP)
+
+	// locus groups by count, descending
+	groups := make([]string, 0, len(locusGroups))
+	width := 0
+	for group := range locusGroups {
+		if group == "" {
+			continue
+		}
+		groups = append(groups, group)
+		width = max(width, len(group))
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if locusGroups[groups[i]] != locusGroups[groups[j]] {
+			return locusGroups[groups[i]] > locusGroups[groups[j]]
+		}
+		return groups[i] < groups[j]
+	})
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, h.String())
+	if !stats.ReleaseDate.IsZero() {
+		fmt.Fprintf(bw, "Release date: %s\n", stats.ReleaseDate.Format(time.DateOnly))
+	}
+	if stats.SourceURL != "" {
+		fmt.Fprintf(bw, "Source: %s\n", stats.SourceURL)
+	}
+	fmt.Fprintln(bw, "Records by locus group:")
+	for _, group := range groups {
+		fmt.Fprintf(bw, "  %-*s  %d\n", width, group, locusGroups[group])
+	}
+	if n := locusGroups[""]; n > 0 {
+		fmt.Fprintf(bw, "  %-*s  %d\n", width, "(none)", n)
+	}
+	fmt.Fprintln(bw, "Indexed fields:")
+	for _, field := range stats.IndexedFields {
+		fmt.Fprintf(bw, "  %s\n", field)
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,84 @@
+package hgnc_go_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestHGNCString(t *testing.T) {
+
+	withdrawn := h.NewRecordBuilder().WithHgncID("HGNC:2").WithSymbol("OLD").WithStatus(h.StatusWithdrawn).Build()
+
+	tests := []struct {
+		name    string
+		records []*h.Record
+		want    string
+	}{
+		{"empty", nil, "HGNC database: 0 records (0 approved, 0 withdrawn), 9 indexed fields"},
+		{"fixtures", []*h.Record{testutil.FixtureTP53(), testutil.FixtureBRCA1(), withdrawn},
+			"HGNC database: 3 records (2 approved, 1 withdrawn), 9 indexed fields"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testutil.NewMockHGNC(tt.records...).String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+
+	noGroup := h.NewRecordBuilder().WithHgncID("HGNC:2").WithSymbol("NOGROUP").WithStatus(h.StatusApproved).Build()
+	indexed := "Indexed fields:\n" +
+		"  ena\n  ensembl_gene_id\n  entrez_id\n  hgnc_id\n  omim_id\n  refseq_accession\n  symbol\n  ucsc_id\n  uniprot_ids\n"
+
+	tests := []struct {
+		name    string
+		records []*h.Record
+		prepare func(hgnc *h.HGNC)
+		want    string
+	}{
+		{
+			"locus groups by count",
+			[]*h.Record{testutil.FixtureMIR21(), testutil.FixtureTP53(), testutil.FixtureBRCA1(), noGroup},
+			func(*h.HGNC) {},
+			"HGNC database: 4 records (4 approved, 0 withdrawn), 9 indexed fields\n" +
+				"Records by locus group:\n" +
+				"  protein-coding gene  2\n" +
+				"  non-coding RNA       1\n" +
+				"  (none)               1\n" +
+				indexed,
+		},
+		{
+			"version metadata",
+			[]*h.Record{testutil.FixtureTP53()},
+			func(hgnc *h.HGNC) {
+				hgnc.SetVersionMetadata(time.Date(2024, 6, 4, 0, 0, 0, 0, time.UTC), "https://example.org/hgnc.txt")
+			},
+			"HGNC database: 1 records (1 approved, 0 withdrawn), 9 indexed fields\n" +
+				"Release date: 2024-06-04\n" +
+				"Source: https://example.org/hgnc.txt\n" +
+				"Records by locus group:\n" +
+				"  protein-coding gene  1\n" +
+				indexed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hgnc := testutil.NewMockHGNC(tt.records...)
+			tt.prepare(hgnc)
+			var buf strings.Builder
+			if err := hgnc.Summary(&buf); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Summary() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package hgnc_go
+
+// GeneStatus is a value of FIELD_STATUS.
+type GeneStatus string
+
+const (
+	StatusApproved  GeneStatus = "Approved"
+	StatusWithdrawn GeneStatus = "Entry Withdrawn"
+)
+
+// StatusEnum returns the status of the Record as a GeneStatus.
+func (r *Record) StatusEnum() GeneStatus {
+	return GeneStatus(r.data[FIELD_STATUS])
+}
+
+// IsApproved checks if the Record is an approved entry.
+func (r *Record) IsApproved() bool {
+	return r.StatusEnum() == StatusApproved
+}
+
+// IsWithdrawn checks if the Record is a withdrawn entry.
+func (r *Record) IsWithdrawn() bool {
+	return r.StatusEnum() == StatusWithdrawn
+}
+
+// FetchByStatus retrieves records with the given status.
+func (h *HGNC) FetchByStatus(status GeneStatus) []*Record {
+	return h.Filter(func(r *Record) bool {
+		return r.StatusEnum() == status
+	})
+}
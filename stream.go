@@ -0,0 +1,64 @@
+package hgnc_go
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+)
+
+// RecordIterator reads records of an HGNC file one at a time.
+// It builds no index and no symbol map, for tools that only need each record once
+// (bulk export, checksumming, ...) without holding the whole database in memory.
+type RecordIterator struct {
+	table   *tableReader
+	closers []io.Closer // closed in reverse order by Close
+}
+
+// LoadTsvStreaming opens an HGNC TSV file for reading records one at a time.
+// The caller must call Close when done.
+func LoadTsvStreaming(filepath string, gzipped bool) (*RecordIterator, error) {
+
+	fh, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	it := &RecordIterator{closers: []io.Closer{fh}}
+
+	var r io.Reader = fh
+	if gzipped {
+		gz, err := gzip.NewReader(fh)
+		if err != nil {
+			it.Close()
+			return nil, err
+		}
+		it.closers = append(it.closers, gz)
+		r = gz
+	}
+
+	if it.table, err = openTable(r, '\t'); err != nil {
+		it.Close()
+		return nil, err
+	}
+	return it, nil
+}
+
+// Next parses and returns the next record. It returns io.EOF after the last record.
+func (it *RecordIterator) Next() (*Record, error) {
+	values, err := it.table.rows.read()
+	if err != nil {
+		return nil, err
+	}
+	return fields2Record(values, it.table.headerMap, nil), nil
+}
+
+// Close releases the underlying file.
+func (it *RecordIterator) Close() error {
+	var firstErr error
+	for i := len(it.closers) - 1; i >= 0; i-- {
+		if err := it.closers[i].Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	it.closers = nil
+	return firstErr
+}
@@ -0,0 +1,44 @@
+package testutil
+
+import (
+	"fmt"
+
+	hgnc "github.com/viktorxia/hgnc-go"
+)
+
+// SyntheticRecords generates n records with unique ids, of roughly the size
+// of real HGNC records, for benchmarks without the complete set file.
+// Record i has symbol "GENE<i>", alias "ALIAS<i>" and entrez id "<i+1>".
+func SyntheticRecords(n int) []*hgnc.Record {
+
+	locusGroups := []hgnc.LocusGroup{
+		hgnc.LocusGroupProteinCoding,
+		hgnc.LocusGroupNonCodingRNA,
+		hgnc.LocusGroupPseudogene,
+		hgnc.LocusGroupOther,
+	}
+
+	records := make([]*hgnc.Record, n)
+	for i := range records {
+		records[i] = hgnc.NewRecordBuilder().
+			WithHgncID(fmt.Sprintf("HGNC:%d", i+1)).
+			WithSymbol(fmt.Sprintf("GENE%d", i)).
+			WithName(fmt.Sprintf("synthetic gene %d", i)).
+			WithLocusGroup(locusGroups[i%len(locusGroups)]).
+			WithStatus(hgnc.StatusApproved).
+			WithLocation(fmt.Sprintf("%dq%d.%d", i%22+1, i%3+1, i%9+1)).
+			WithAliasSymbols(fmt.Sprintf("ALIAS%d", i)).
+			WithEntrezID(fmt.Sprint(i+1)).
+			WithEnsemblGeneID(fmt.Sprintf("ENSG%011d", i+1)).
+			With(hgnc.FIELD_VEGA_ID, fmt.Sprintf("OTTHUMG%011d", i+1)).
+			WithRefseqAccession(fmt.Sprintf("NM_%06d", i+1)).
+			WithManeSelect(fmt.Sprintf("ENST%011d.1", i+1), fmt.Sprintf("NM_%06d.1", i+1)).
+			Build()
+	}
+	return records
+}
+
+// NewSyntheticHGNC creates an HGNC database of SyntheticRecords(n).
+func NewSyntheticHGNC(n int) *hgnc.HGNC {
+	return NewMockHGNC(SyntheticRecords(n)...)
+}
@@ -0,0 +1,110 @@
+/*
+Package testutil builds small in-memory HGNC databases for tests, so that code
+depending on *hgnc_go.HGNC can be tested without the complete set file.
+
+	h := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1())
+	records := h.Fetch("p53", hgnc_go.FIELD_SYMBOL)
+*/
+package testutil
+
+import (
+	"strings"
+
+	hgnc "github.com/viktorxia/hgnc-go"
+)
+
+// NewMockHGNC creates an HGNC database of the given records, with the same
+// indexes and symbol maps as if they were loaded from a TSV file.
+func NewMockHGNC(records ...*hgnc.Record) *hgnc.HGNC {
+
+	// a header-only file, so every known field is registered
+	header := strings.Join(hgnc.AllFieldNames(), "\t") + "\n"
+	h, err := hgnc.LoadFromReader(strings.NewReader(header))
+	if err != nil {
+		// the header is generated above, this never happens
+		panic(err)
+	}
+
+	for _, record := range records {
+		h.AddRecord(record)
+	}
+	return h
+}
+
+// FixtureTP53 returns the record of TP53, a protein-coding gene with aliases.
+func FixtureTP53() *hgnc.Record {
+	return hgnc.NewRecord(map[hgnc.Field]string{
+		hgnc.FIELD_HGNC_ID:                "HGNC:11998",
+		hgnc.FIELD_SYMBOL:                 "TP53",
+		hgnc.FIELD_NAME:                   "tumor protein p53",
+		hgnc.FIELD_LOCUS_GROUP:            "protein-coding gene",
+		hgnc.FIELD_LOCUS_TYPE:             "gene with protein product",
+		hgnc.FIELD_STATUS:                 "Approved",
+		hgnc.FIELD_LOCATION:               "17p13.1",
+		hgnc.FIELD_LOCATION_SORTABLE:      "17p13.1",
+		hgnc.FIELD_ALIAS_SYMBOL:           "p53|LFS1",
+		hgnc.FIELD_ALIAS_NAME:             "Li-Fraumeni syndrome",
+		hgnc.FIELD_DATE_APPROVED_RESERVED: "1986-01-01",
+		hgnc.FIELD_DATE_MODIFIED:          "2023-01-10",
+		hgnc.FIELD_ENTREZ_ID:              "7157",
+		hgnc.FIELD_ENSEMBL_GENE_ID:        "ENSG00000141510",
+		hgnc.FIELD_UCSC_ID:                "uc060aur.1",
+		hgnc.FIELD_ENA:                    "AF307851",
+		hgnc.FIELD_REFSEQ_ACCESSION:       "NM_000546",
+		hgnc.FIELD_CCDS_ID:                "CCDS11118|CCDS45605",
+		hgnc.FIELD_UNIPROT_IDS:            "P04637",
+		hgnc.FIELD_PUBMED_ID:              "6396087|3456488",
+		hgnc.FIELD_MGD_ID:                 "MGI:98834",
+		hgnc.FIELD_RGD_ID:                 "RGD:3889",
+		hgnc.FIELD_OMIM_ID:                "191170",
+		hgnc.FIELD_MANE_SELECT:            "ENST00000269305.9|NM_000546.6",
+	})
+}
+
+// FixtureBRCA1 returns the record of BRCA1, a protein-coding gene in several gene families.
+func FixtureBRCA1() *hgnc.Record {
+	return hgnc.NewRecord(map[hgnc.Field]string{
+		hgnc.FIELD_HGNC_ID:                "HGNC:1100",
+		hgnc.FIELD_SYMBOL:                 "BRCA1",
+		hgnc.FIELD_NAME:                   "BRCA1 DNA repair associated",
+		hgnc.FIELD_LOCUS_GROUP:            "protein-coding gene",
+		hgnc.FIELD_LOCUS_TYPE:             "gene with protein product",
+		hgnc.FIELD_STATUS:                 "Approved",
+		hgnc.FIELD_LOCATION:               "17q21.31",
+		hgnc.FIELD_LOCATION_SORTABLE:      "17q21.31",
+		hgnc.FIELD_ALIAS_SYMBOL:           "RNF53|BRCC1|PPP1R53|FANCS",
+		hgnc.FIELD_GENE_FAMILY:            "Ring finger proteins|BRCA1 A complex",
+		hgnc.FIELD_GENE_FAMILY_ID:         "58|1328",
+		hgnc.FIELD_DATE_APPROVED_RESERVED: "1991-01-01",
+		hgnc.FIELD_DATE_MODIFIED:          "2024-05-01",
+		hgnc.FIELD_ENTREZ_ID:              "672",
+		hgnc.FIELD_ENSEMBL_GENE_ID:        "ENSG00000012048",
+		hgnc.FIELD_UCSC_ID:                "uc002ict.4",
+		hgnc.FIELD_REFSEQ_ACCESSION:       "NM_007294",
+		hgnc.FIELD_UNIPROT_IDS:            "P38398",
+		hgnc.FIELD_PUBMED_ID:              "8091231",
+		hgnc.FIELD_MGD_ID:                 "MGI:104537",
+		hgnc.FIELD_RGD_ID:                 "RGD:2218",
+		hgnc.FIELD_OMIM_ID:                "113705",
+		hgnc.FIELD_MANE_SELECT:            "ENST00000357654.9|NM_007294.4",
+	})
+}
+
+// FixtureMIR21 returns the record of MIR21, a non-coding RNA gene with a miRBase ID.
+func FixtureMIR21() *hgnc.Record {
+	return hgnc.NewRecord(map[hgnc.Field]string{
+		hgnc.FIELD_HGNC_ID:                "HGNC:31586",
+		hgnc.FIELD_SYMBOL:                 "MIR21",
+		hgnc.FIELD_NAME:                   "microRNA 21",
+		hgnc.FIELD_LOCUS_GROUP:            "non-coding RNA",
+		hgnc.FIELD_LOCUS_TYPE:             "RNA, micro",
+		hgnc.FIELD_STATUS:                 "Approved",
+		hgnc.FIELD_LOCATION:               "17q23.1",
+		hgnc.FIELD_LOCATION_SORTABLE:      "17q23.1",
+		hgnc.FIELD_ALIAS_SYMBOL:           "hsa-mir-21",
+		hgnc.FIELD_DATE_APPROVED_RESERVED: "2003-05-13",
+		hgnc.FIELD_ENTREZ_ID:              "406991",
+		hgnc.FIELD_ENSEMBL_GENE_ID:        "ENSG00000284190",
+		hgnc.FIELD_MIRBASE:                "MI0000077",
+	})
+}
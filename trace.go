@@ -0,0 +1,17 @@
+//go:build !otel
+
+package hgnc_go
+
+import "context"
+
+// FetchWithTrace is like Fetch, but records an OpenTelemetry span "hgnc.Fetch" from ctx.
+// Tracing requires building with the otel tag, otherwise it's the same as Fetch.
+func (h *HGNC) FetchWithTrace(ctx context.Context, value string, query Field) []*Record {
+	return h.Fetch(value, query)
+}
+
+// LookupWithTrace is like Lookup, but records an OpenTelemetry span "hgnc.Lookup" from ctx.
+// Tracing requires building with the otel tag, otherwise it's the same as Lookup.
+func (h *HGNC) LookupWithTrace(ctx context.Context, value string, query, target Field) []string {
+	return h.Lookup(value, query, target)
+}
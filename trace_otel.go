@@ -0,0 +1,47 @@
+//go:build otel
+
+// The go.opentelemetry.io/otel module is only compiled in with the otel tag:
+//
+//	go build -tags otel
+
+package hgnc_go
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer creates spans with the global TracerProvider.
+var tracer = otel.Tracer("github.com/viktorxia/hgnc-go")
+
+// FetchWithTrace is like Fetch, but records an OpenTelemetry span "hgnc.Fetch" from ctx,
+// with the query field and the number of records found.
+func (h *HGNC) FetchWithTrace(ctx context.Context, value string, query Field) []*Record {
+
+	_, span := tracer.Start(ctx, "hgnc.Fetch",
+		trace.WithAttributes(attribute.String("hgnc.query_field", query.String())))
+	defer span.End()
+
+	results := h.Fetch(value, query)
+	span.SetAttributes(attribute.Int("hgnc.result_count", len(results)))
+	return results
+}
+
+// LookupWithTrace is like Lookup, but records an OpenTelemetry span "hgnc.Lookup" from ctx,
+// with the query and target fields and the number of values found.
+func (h *HGNC) LookupWithTrace(ctx context.Context, value string, query, target Field) []string {
+
+	_, span := tracer.Start(ctx, "hgnc.Lookup",
+		trace.WithAttributes(
+			attribute.String("hgnc.query_field", query.String()),
+			attribute.String("hgnc.target_field", target.String()),
+		))
+	defer span.End()
+
+	results := h.Lookup(value, query, target)
+	span.SetAttributes(attribute.Int("hgnc.result_count", len(results)))
+	return results
+}
@@ -0,0 +1,86 @@
+//go:build otel
+
+package hgnc_go_test
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	h "github.com/viktorxia/hgnc-go"
+	"github.com/viktorxia/hgnc-go/testutil"
+)
+
+func TestTrace(t *testing.T) {
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer provider.Shutdown(context.Background())
+	otel.SetTracerProvider(provider)
+
+	hgnc := testutil.NewMockHGNC(testutil.FixtureTP53(), testutil.FixtureBRCA1())
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		query     func()
+		wantSpan  string
+		wantAttrs []attribute.KeyValue
+	}{
+		{
+			"fetch",
+			func() { hgnc.FetchWithTrace(ctx, "TP53", h.FIELD_SYMBOL) },
+			"hgnc.Fetch",
+			[]attribute.KeyValue{
+				attribute.String("hgnc.query_field", "symbol"),
+				attribute.Int("hgnc.result_count", 1),
+			},
+		},
+		{
+			"fetch not found",
+			func() { hgnc.FetchWithTrace(ctx, "NOPE", h.FIELD_SYMBOL) },
+			"hgnc.Fetch",
+			[]attribute.KeyValue{
+				attribute.String("hgnc.query_field", "symbol"),
+				attribute.Int("hgnc.result_count", 0),
+			},
+		},
+		{
+			"lookup",
+			func() { hgnc.LookupWithTrace(ctx, "672", h.FIELD_ENTREZ_ID, h.FIELD_SYMBOL) },
+			"hgnc.Lookup",
+			[]attribute.KeyValue{
+				attribute.String("hgnc.query_field", "entrez_id"),
+				attribute.String("hgnc.target_field", "symbol"),
+				attribute.Int("hgnc.result_count", 1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+			tt.query()
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				t.Fatalf("got %d spans, want 1", len(spans))
+			}
+			if spans[0].Name != tt.wantSpan {
+				t.Errorf("span name = %q, want %q", spans[0].Name, tt.wantSpan)
+			}
+			attrs := make(map[attribute.Key]attribute.Value)
+			for _, kv := range spans[0].Attributes {
+				attrs[kv.Key] = kv.Value
+			}
+			for _, want := range tt.wantAttrs {
+				if got, ok := attrs[want.Key]; !ok || got != want.Value {
+					t.Errorf("attribute %s = %v, want %v", want.Key, got.Emit(), want.Value.Emit())
+				}
+			}
+		})
+	}
+}
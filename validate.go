@@ -0,0 +1,154 @@
+package hgnc_go
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ValidationResult reports non-fatal issues found in the TSV header when loading
+// with WithValidation.
+type ValidationResult struct {
+	UnknownColumns        []string // columns not defined as Field constants
+	MissingIndexedColumns []string // indexed fields absent from the header
+	MovedColumns          []string // known columns not at their Field.ColumnNumber position
+}
+
+// HasIssues tells whether any issue was found.
+func (v ValidationResult) HasIssues() bool {
+	return len(v.UnknownColumns) > 0 || len(v.MissingIndexedColumns) > 0 || len(v.MovedColumns) > 0
+}
+
+// ValidationResult returns the header validation result of the last load.
+// It is empty unless the database was loaded with WithValidation.
+func (h *HGNC) ValidationResult() ValidationResult {
+	if h == nil {
+		panic("HGNC is nil")
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.validation
+}
+
+// LoadTsvWithValidation is like LoadTsv with WithValidation(strict), but also returns
+// the header validation result, even when loading fails on strict validation.
+func LoadTsvWithValidation(filepath string, gzipped, strict bool, opts ...LoadOption) (*HGNC, ValidationResult, error) {
+	var result ValidationResult
+	opts = append(append([]LoadOption{}, opts...), WithValidation(strict), func(cfg *loadConfig) {
+		cfg.validationOut = &result
+	})
+	h, err := LoadTsv(filepath, gzipped, opts...)
+	return h, result, err
+}
+
+// validateHeader compares parsed header columns with known fields.
+func validateHeader(headerMap map[string]int) ValidationResult {
+
+	var result ValidationResult
+
+	for column := range headerMap {
+		if _, ok := fieldDesc[Field(column)]; !ok {
+			result.UnknownColumns = append(result.UnknownColumns, column)
+		}
+	}
+	sort.Strings(result.UnknownColumns)
+
+	for column, idx := range headerMap {
+		if number := Field(column).ColumnNumber(); number > 0 && number != idx+1 {
+			result.MovedColumns = append(result.MovedColumns, column)
+		}
+	}
+	sort.Strings(result.MovedColumns)
+
+	for _, field := range indexedFields {
+		if _, ok := headerMap[string(field)]; !ok {
+			result.MissingIndexedColumns = append(result.MissingIndexedColumns, string(field))
+		}
+	}
+
+	return result
+}
+
+// error returns an error for fatal issues in strict mode, nil if there is none.
+func (v ValidationResult) error() error {
+	if len(v.MissingIndexedColumns) == 0 {
+		return nil
+	}
+	return fmt.Errorf(
+		"indexed columns missing from header: %s",
+		strings.Join(v.MissingIndexedColumns, ", "),
+	)
+}
+
+// ValidationIssue is a problem found in a record by ValidateRecords.
+type ValidationIssue struct {
+	HgncID string // HGNC ID of the record, may be empty or malformed itself
+	Field  string
+	Issue  string
+}
+
+var (
+	hgncIDPattern  = regexp.MustCompile(`^HGNC:\d+$`)
+	ensemblPattern = regexp.MustCompile(`^ENSG\d{11}$`)
+)
+
+// ValidateRecords checks format constraints of all records and reports duplicate HGNC IDs.
+// It is useful after Merge or AddRecord, when external data is introduced.
+func (h *HGNC) ValidateRecords() []ValidationIssue {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	issues := make([]ValidationIssue, 0)
+	seen := make(map[string]struct{}, len(h.records))
+	for _, record := range h.records {
+		hgncID := record.data[FIELD_HGNC_ID]
+		for _, issue := range recordIssues(record) {
+			issues = append(issues, ValidationIssue{HgncID: hgncID, Field: string(issue.field), Issue: issue.issue})
+		}
+		if hgncID == "" {
+			continue
+		}
+		if _, ok := seen[hgncID]; ok {
+			issues = append(issues, ValidationIssue{HgncID: hgncID, Field: string(FIELD_HGNC_ID), Issue: "duplicate HGNC ID"})
+		}
+		seen[hgncID] = struct{}{}
+	}
+	return issues
+}
+
+// fieldIssue is a format problem of a single field.
+type fieldIssue struct {
+	field Field
+	issue string
+}
+
+// recordIssues checks format constraints of a single record.
+// Empty Ensembl and Entrez IDs are allowed, an empty HGNC ID is not.
+func recordIssues(record *Record) []fieldIssue {
+
+	var issues []fieldIssue
+
+	if hgncID := record.data[FIELD_HGNC_ID]; !hgncIDPattern.MatchString(hgncID) {
+		issues = append(issues, fieldIssue{FIELD_HGNC_ID, fmt.Sprintf("invalid HGNC ID %q, expected HGNC:<number>", hgncID)})
+	}
+	if ensg := record.data[FIELD_ENSEMBL_GENE_ID]; ensg != "" && !ensemblPattern.MatchString(ensg) {
+		issues = append(issues, fieldIssue{FIELD_ENSEMBL_GENE_ID, fmt.Sprintf("invalid Ensembl gene ID %q, expected ENSG<11 digits>", ensg)})
+	}
+	if entrezID := record.data[FIELD_ENTREZ_ID]; entrezID != "" {
+		if _, err := strconv.ParseUint(entrezID, 10, 64); err != nil {
+			issues = append(issues, fieldIssue{FIELD_ENTREZ_ID, fmt.Sprintf("invalid Entrez ID %q, expected an integer", entrezID)})
+		}
+	}
+	if status := record.StatusEnum(); status != StatusApproved && status != StatusWithdrawn {
+		issues = append(issues, fieldIssue{FIELD_STATUS, fmt.Sprintf("unknown status %q, expected \"Approved\" or \"Entry Withdrawn\"", status)})
+	}
+
+	return issues
+}
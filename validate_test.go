@@ -0,0 +1,77 @@
+package hgnc_go_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+)
+
+// header returns a header line of columns.
+func header(columns ...string) string {
+	return strings.Join(columns, "\t") + "\n"
+}
+
+// without returns names without name.
+func without(names []string, name string) []string {
+	return slices.DeleteFunc(slices.Clone(names), func(s string) bool { return s == name })
+}
+
+func TestLoadTsvWithValidation(t *testing.T) {
+
+	all := h.AllFieldNames()
+	swapped := slices.Clone(all)
+	swapped[1], swapped[2] = swapped[2], swapped[1] // symbol and name
+	// columns after a missing one are moved
+	shifted := slices.Clone(all[slices.Index(all, "entrez_id")+1:])
+	slices.Sort(shifted)
+
+	tests := []struct {
+		name    string
+		header  string
+		strict  bool
+		wantErr bool
+		want    h.ValidationResult
+	}{
+		{"all fields", header(all...), true, false, h.ValidationResult{}},
+		{"unknown column", header(append(slices.Clone(all), "extra")...), true, false,
+			h.ValidationResult{UnknownColumns: []string{"extra"}}},
+		{"moved columns", header(swapped...), true, false,
+			h.ValidationResult{MovedColumns: []string{"name", "symbol"}}},
+		{"missing indexed column", header(without(all, "entrez_id")...), false, false,
+			h.ValidationResult{MissingIndexedColumns: []string{"entrez_id"}, MovedColumns: shifted}},
+		{"missing indexed column, strict", header(without(all, "entrez_id")...), true, true,
+			h.ValidationResult{MissingIndexedColumns: []string{"entrez_id"}, MovedColumns: shifted}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "hgnc.tsv")
+			if err := os.WriteFile(path, []byte(tt.header), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			hgnc, result, err := h.LoadTsvWithValidation(path, false, tt.strict, h.WithLogger(testLogger))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadTsvWithValidation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !equalValidation(result, tt.want) {
+				t.Errorf("LoadTsvWithValidation() result = %+v, want %+v", result, tt.want)
+			}
+			if got, want := result.HasIssues(), !equalValidation(tt.want, h.ValidationResult{}); got != want {
+				t.Errorf("HasIssues() = %v, want %v", got, want)
+			}
+			if err == nil && !equalValidation(hgnc.ValidationResult(), result) {
+				t.Errorf("HGNC.ValidationResult() = %+v, want %+v", hgnc.ValidationResult(), result)
+			}
+		})
+	}
+}
+
+func equalValidation(a, b h.ValidationResult) bool {
+	return slices.Equal(a.UnknownColumns, b.UnknownColumns) &&
+		slices.Equal(a.MissingIndexedColumns, b.MissingIndexedColumns) &&
+		slices.Equal(a.MovedColumns, b.MovedColumns)
+}
@@ -0,0 +1,39 @@
+package hgnc_go
+
+import "strings"
+
+// VCFGeneAnnotation is the HGNC annotation of the gene of a variant.
+type VCFGeneAnnotation struct {
+	HgncID     string
+	Symbol     string // current standard symbol, also for alias / previous symbol input
+	EntrezID   string
+	EnsemblID  string
+	IsCoding   bool // protein-coding locus group, same rule as IsCodingGene
+	LocusType  string
+	ManeSelect string
+}
+
+// AnnotateVCFGene annotates the gene of a VCF ANN (or CSQ) gene field, given as a symbol,
+// hgnc id, entrez id or ensembl id (see SetEnsgVersionStripping). It returns nil if the gene is
+// not found. If several records match, the first one is used.
+func (h *HGNC) AnnotateVCFGene(geneField string) *VCFGeneAnnotation {
+
+	gene := strings.TrimSpace(geneField)
+	gene, field := h.classifyGene(gene)
+
+	records := h.Fetch(gene, field)
+	if len(records) == 0 {
+		return nil
+	}
+	record := records[0]
+
+	return &VCFGeneAnnotation{
+		HgncID:     record.data[FIELD_HGNC_ID],
+		Symbol:     record.data[FIELD_SYMBOL],
+		EntrezID:   record.data[FIELD_ENTREZ_ID],
+		EnsemblID:  record.data[FIELD_ENSEMBL_GENE_ID],
+		IsCoding:   isCodingLocusGroup(record.data[FIELD_LOCUS_GROUP]),
+		LocusType:  record.data[FIELD_LOCUS_TYPE],
+		ManeSelect: record.data[FIELD_MANE_SELECT],
+	}
+}
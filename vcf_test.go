@@ -0,0 +1,53 @@
+package hgnc_go_test
+
+import (
+	"testing"
+
+	h "github.com/viktorxia/hgnc-go"
+)
+
+func TestAnnotateVCFGene(t *testing.T) {
+
+	hgnc := newGeneHGNC()
+	tp53 := &h.VCFGeneAnnotation{
+		HgncID:     "HGNC:11998",
+		Symbol:     "TP53",
+		EntrezID:   "7157",
+		EnsemblID:  "ENSG00000141510",
+		IsCoding:   true,
+		LocusType:  "gene with protein product",
+		ManeSelect: "ENST00000269305.9|NM_000546.6",
+	}
+	mir21 := &h.VCFGeneAnnotation{
+		HgncID:    "HGNC:31586",
+		Symbol:    "MIR21",
+		EntrezID:  "406991",
+		EnsemblID: "ENSG00000284190",
+		LocusType: "RNA, micro",
+	}
+
+	tests := []struct {
+		name      string
+		geneField string
+		want      *h.VCFGeneAnnotation
+	}{
+		{"symbol", "TP53", tp53},
+		{"alias", "p53", tp53},
+		{"hgnc id", "HGNC:11998", tp53},
+		{"entrez id", "7157", tp53},
+		{"ensembl id", "ENSG00000141510", tp53},
+		{"versioned ensembl id", "ENSG00000141510.17", tp53},
+		{"surrounding spaces", " TP53\t", tp53},
+		{"non-coding", "MIR21", mir21},
+		{"unknown", "NOPE", nil},
+		{"empty", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hgnc.AnnotateVCFGene(tt.geneField)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("AnnotateVCFGene(%q) = %+v, want %+v", tt.geneField, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package hgnc_go
+
+import (
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// releaseDatePattern matches dates like 2024-01-01 in file names and comment lines,
+// e.g. "hgnc_complete_set_2024-01-01.txt.gz" or "# release date: 2024-01-01".
+var releaseDatePattern = regexp.MustCompile(`(\d{4}-\d{2}-\d{2})`)
+
+// SetVersionMetadata records which HGNC release is loaded.
+func (h *HGNC) SetVersionMetadata(releaseDate time.Time, sourceURL string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.releaseDate = releaseDate
+	h.sourceURL = sourceURL
+}
+
+// VersionMetadata returns the release date and source URL of the loaded HGNC data.
+// releaseDate is the zero time if it is unknown.
+func (h *HGNC) VersionMetadata() (releaseDate time.Time, sourceURL string) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.releaseDate, h.sourceURL
+}
+
+// detectReleaseDate finds a release date (YYYY-MM-DD) in s, returns false if there is none.
+func detectReleaseDate(s string) (time.Time, bool) {
+	for _, m := range releaseDatePattern.FindAllString(s, -1) {
+		if t, err := time.Parse(time.DateOnly, m); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// detectReleaseDateFromPath finds a release date in the file name of path.
+func detectReleaseDateFromPath(path string) (time.Time, bool) {
+	return detectReleaseDate(filepath.Base(path))
+}
@@ -0,0 +1,76 @@
+package hgnc_go
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+// watchInterval is how often WatchFile checks the file.
+var watchInterval = 5 * time.Second
+
+// WatchFile polls a TSV file (as loaded by LoadTsv) and reloads it whenever its modification
+// time or size changes, calling onChange with the new database or the load error. h itself is
+// never modified, the caller swaps references (e.g. with atomic.Pointer). The file is reloaded
+// with the LoadOptions h was loaded with (delimiter, logger, validation, ...), and the auto symbol
+// normalization and ensg version stripping settings of h are carried over to new databases.
+// A WithChecksum digest is only checked on the first load, changed files are not verified.
+//
+// WatchFile blocks until ctx is done or h is closed and then returns the context error,
+// run it in a goroutine. It returns early if the file can't be stat'ed at start, or with
+// ErrClosed if h is already closed. onChange must not call h.Close, Close waits for WatchFile
+// to return.
+func (h *HGNC) WatchFile(ctx context.Context, filepath string, gzipped bool, onChange func(newDB *HGNC, err error)) error {
+
+	if h == nil {
+		panic("HGNC is nil")
+	}
+
+	last, err := os.Stat(filepath)
+	if err != nil {
+		return err
+	}
+
+	ctx, done, err := h.startTask(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(filepath)
+		if err != nil {
+			// e.g. being replaced, try again on next tick
+			continue
+		}
+		if info.ModTime().Equal(last.ModTime()) && info.Size() == last.Size() {
+			continue
+		}
+		last = info
+
+		h.mu.RLock()
+		cfg := h.loadCfg
+		h.mu.RUnlock()
+		if cfg == nil {
+			cfg = newLoadConfig(nil)
+		}
+
+		newDB, err := loadTsv(filepath, gzipped, cfg)
+		if err == nil {
+			h.mu.RLock()
+			newDB.autoNormSymbol = h.autoNormSymbol
+			newDB.keepEnsgVer = h.keepEnsgVer
+			h.mu.RUnlock()
+		}
+		onChange(newDB, err)
+	}
+}
@@ -0,0 +1,92 @@
+package hgnc_go
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	watchHeader = "hgnc_id\tsymbol\tname\tentrez_id\n"
+	watchTP53   = "HGNC:11998\tTP53\ttumor protein p53\t7157\n"
+	watchBRCA1  = "HGNC:1100\tBRCA1\tBRCA1 DNA repair associated\t672\n"
+)
+
+func TestWatchFile(t *testing.T) {
+
+	defer func(interval time.Duration) { watchInterval = interval }(watchInterval)
+	watchInterval = 10 * time.Millisecond
+
+	tests := []struct {
+		name string
+		opts func(content []byte) []LoadOption
+	}{
+		{"default options", func([]byte) []LoadOption { return nil }},
+		{"with checksum", func(content []byte) []LoadOption {
+			digest := sha256.Sum256(content)
+			return []LoadOption{WithChecksum(digest[:], crypto.SHA256)}
+		}},
+		{"with delimiter", func([]byte) []LoadOption { return []LoadOption{WithDelimiter('\t')} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "hgnc.tsv")
+			content := []byte(watchHeader + watchTP53)
+			if err := os.WriteFile(path, content, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			h, err := LoadTsv(path, false, tt.opts(content)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			type change struct {
+				db  *HGNC
+				err error
+			}
+			changes := make(chan change, 1)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go h.WatchFile(ctx, path, false, func(db *HGNC, err error) {
+				select {
+				case changes <- change{db, err}:
+				default:
+				}
+			})
+
+			// let WatchFile stat the original file first
+			time.Sleep(5 * watchInterval)
+			// replaced atomically, so a half-written file is never seen; a different size
+			// is a change even if the mtime resolution is coarse
+			tmp := path + ".tmp"
+			if err := os.WriteFile(tmp, []byte(watchHeader+watchTP53+watchBRCA1), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Rename(tmp, path); err != nil {
+				t.Fatal(err)
+			}
+
+			select {
+			case c := <-changes:
+				if c.err != nil {
+					t.Fatalf("reload failed: %v", c.err)
+				}
+				if got := c.db.RecordCount(); got != 2 {
+					t.Errorf("reloaded %d records, want 2", got)
+				}
+				if got := len(c.db.Fetch("BRCA1", FIELD_SYMBOL)); got != 1 {
+					t.Errorf("Fetch(BRCA1) on the reloaded database returned %d records, want 1", got)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("onChange was not called")
+			}
+			if got := h.RecordCount(); got != 1 {
+				t.Errorf("watched database has %d records, want 1 (it must not be modified)", got)
+			}
+		})
+	}
+}